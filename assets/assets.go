@@ -0,0 +1,21 @@
+// Package assets embeds the files the binaries need at runtime.
+package assets
+
+import (
+	"embed"
+	"io/fs"
+
+	"github.com/pkg/errors"
+)
+
+//go:embed migrations
+var migrations embed.FS
+
+// Migrations returns the schema migrations of the given database type.
+func Migrations(dbType string) (fs.FS, error) {
+	dir := "migrations/" + dbType
+	if _, err := fs.Stat(migrations, dir); err != nil {
+		return nil, errors.Errorf("no migrations for db type %q", dbType)
+	}
+	return fs.Sub(migrations, dir)
+}
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS `ingest_runs` ( 
+    `id` int NOT NULL AUTO_INCREMENT,
+    `filename` varchar(32), 
+    `satellites` int NOT NULL, 
+    `measurements` int NOT NULL, 
+    `computations` int NOT NULL, 
+    `created` datetime NOT NULL,
+    PRIMARY KEY (`id`)
+);
@@ -0,0 +1,5 @@
+ALTER TABLE `measurements` MODIFY `timestamp` varchar(32);
+UPDATE `measurements` SET `timestamp` = CONCAT(SUBSTRING(`timestamp`, 1, 19), ' +0000 UTC');
+
+ALTER TABLE `computations` MODIFY `duration` varchar(32);
+UPDATE `computations` SET `duration` = CONCAT(CAST(`duration` AS SIGNED) DIV 1000000000, 's');
@@ -0,0 +1,20 @@
+-- timestamps were stored as time.Time.String(), e.g. "2016-02-20 15:19:00 +0000 UTC"
+UPDATE `measurements` SET `timestamp` = DATE_FORMAT(
+    CONVERT_TZ(
+        CAST(SUBSTRING_INDEX(`timestamp`, ' ', 2) AS DATETIME(6)),
+        CONCAT(SUBSTRING(SUBSTRING_INDEX(SUBSTRING_INDEX(`timestamp`, ' ', 3), ' ', -1), 1, 3), ':', SUBSTRING(SUBSTRING_INDEX(SUBSTRING_INDEX(`timestamp`, ' ', 3), ' ', -1), 4, 2)),
+        '+00:00'),
+    '%Y-%m-%d %H:%i:%s.%f');
+ALTER TABLE `measurements` MODIFY `timestamp` DATETIME(6);
+
+-- durations were stored as time.Duration.String(), e.g. "1h2m30.5s" or "1.5ms",
+-- convert them to nanoseconds
+UPDATE `computations` SET `duration` = CAST(ROUND(CASE
+    WHEN `duration` LIKE '%ns' THEN CAST(SUBSTRING(`duration`, 1, CHAR_LENGTH(`duration`) - 2) AS DECIMAL(30, 9))
+    WHEN `duration` LIKE '%us' OR `duration` LIKE '%µs' THEN CAST(SUBSTRING(`duration`, 1, CHAR_LENGTH(`duration`) - 2) AS DECIMAL(30, 9)) * 1000
+    WHEN `duration` LIKE '%ms' THEN CAST(SUBSTRING(`duration`, 1, CHAR_LENGTH(`duration`) - 2) AS DECIMAL(30, 9)) * 1000000
+    ELSE (CAST(IF(LOCATE('h', `duration`) > 0, SUBSTRING_INDEX(`duration`, 'h', 1), '0') AS DECIMAL(30, 9)) * 3600
+        + CAST(IF(LOCATE('m', SUBSTRING_INDEX(`duration`, 'h', -1)) > 0, SUBSTRING_INDEX(SUBSTRING_INDEX(`duration`, 'h', -1), 'm', 1), '0') AS DECIMAL(30, 9)) * 60
+        + CAST(TRIM(TRAILING 's' FROM SUBSTRING_INDEX(SUBSTRING_INDEX(`duration`, 'h', -1), 'm', -1)) AS DECIMAL(30, 9))) * 1000000000
+END) AS SIGNED);
+ALTER TABLE `computations` MODIFY `duration` BIGINT;
@@ -0,0 +1,4 @@
+-- MySQL drops the implicit index of the idSat foreign key once the composite
+-- index can serve it, so the foreign key needs an index of its own again.
+CREATE INDEX `measurements_idSat` ON `measurements` (`idSat`);
+DROP INDEX `measurements_idSat_timestamp` ON `measurements`;
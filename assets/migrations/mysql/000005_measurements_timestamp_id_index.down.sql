@@ -0,0 +1 @@
+DROP INDEX `measurements_timestamp_id` ON `measurements`;
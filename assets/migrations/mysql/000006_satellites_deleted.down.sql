@@ -0,0 +1 @@
+ALTER TABLE `satellites` DROP COLUMN `deleted`;
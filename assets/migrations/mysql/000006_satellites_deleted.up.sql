@@ -0,0 +1 @@
+ALTER TABLE `satellites` ADD COLUMN `deleted` DATETIME(6) NULL;
@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS `satellite_tags`;
+
+ALTER TABLE `satellites`
+    DROP COLUMN `satType`,
+    DROP COLUMN `description`,
+    DROP COLUMN `mission`,
+    DROP COLUMN `operator`,
+    DROP COLUMN `launchDate`,
+    DROP COLUMN `status`;
@@ -0,0 +1,15 @@
+ALTER TABLE `satellites`
+    ADD COLUMN `satType` varchar(8) NOT NULL DEFAULT '',
+    ADD COLUMN `description` varchar(1024) NOT NULL DEFAULT '',
+    ADD COLUMN `mission` varchar(64) NOT NULL DEFAULT '',
+    ADD COLUMN `operator` varchar(64) NOT NULL DEFAULT '',
+    ADD COLUMN `launchDate` date NULL,
+    ADD COLUMN `status` varchar(16) NOT NULL DEFAULT 'active';
+
+CREATE TABLE IF NOT EXISTS `satellite_tags` (
+    `idSat` int NOT NULL,
+    `tag` varchar(64) NOT NULL,
+    PRIMARY KEY (`idSat`, `tag`),
+    INDEX `satellite_tags_tag` (`tag`),
+    FOREIGN KEY (`idSat`) REFERENCES `satellites`(`id`) ON DELETE CASCADE ON UPDATE NO ACTION
+);
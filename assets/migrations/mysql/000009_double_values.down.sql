@@ -0,0 +1,17 @@
+ALTER TABLE `measurements`
+    MODIFY `ionoIndex` float,
+    MODIFY `ndviIndex` float,
+    MODIFY `radiationIndex` float;
+ALTER TABLE `computations`
+    MODIFY `maxIono` float,
+    MODIFY `minIono` float,
+    MODIFY `avgIono` float,
+    MODIFY `maxNdvi` float,
+    MODIFY `minNdvi` float,
+    MODIFY `avgNdvi` float,
+    MODIFY `maxRad` float,
+    MODIFY `minRad` float,
+    MODIFY `avgRad` float,
+    MODIFY `maxSpec` float,
+    MODIFY `minSpec` float,
+    MODIFY `avgSpec` float;
@@ -0,0 +1,17 @@
+ALTER TABLE `measurements`
+    MODIFY `ionoIndex` double,
+    MODIFY `ndviIndex` double,
+    MODIFY `radiationIndex` double;
+ALTER TABLE `computations`
+    MODIFY `maxIono` double,
+    MODIFY `minIono` double,
+    MODIFY `avgIono` double,
+    MODIFY `maxNdvi` double,
+    MODIFY `minNdvi` double,
+    MODIFY `avgNdvi` double,
+    MODIFY `maxRad` double,
+    MODIFY `minRad` double,
+    MODIFY `avgRad` double,
+    MODIFY `maxSpec` double,
+    MODIFY `minSpec` double,
+    MODIFY `avgSpec` double;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS measurements;
+DROP TABLE IF EXISTS computations;
+DROP TABLE IF EXISTS satellites;
\ No newline at end of file
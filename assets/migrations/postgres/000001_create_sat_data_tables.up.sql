@@ -0,0 +1,38 @@
+CREATE TABLE IF NOT EXISTS "satellites" ( 
+    "id" serial NOT NULL, 
+    "name" varchar(32) NOT NULL UNIQUE,
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "measurements" ( 
+    "id" serial NOT NULL,
+    "filename" varchar(32), 
+    "idSat" int NOT NULL, 
+    "timestamp" varchar(32), 
+    "ionoIndex" real, 
+    "ndviIndex" real, 
+    "radiationIndex" real, 
+    "specificMeasurement" varchar(32),
+    PRIMARY KEY ("id"),
+    FOREIGN KEY ("idSat") REFERENCES "satellites"("id") ON DELETE NO ACTION ON UPDATE NO ACTION
+);
+
+CREATE TABLE IF NOT EXISTS "computations" ( 
+    "id" serial NOT NULL,
+    "idSat" int NOT NULL UNIQUE, 
+    "duration" varchar(32), 
+    "maxIono" real, 
+    "minIono" real, 
+    "avgIono" real, 
+    "maxNdvi" real, 
+    "minNdvi" real, 
+    "avgNdvi" real, 
+    "maxRad" real, 
+    "minRad" real, 
+    "avgRad" real, 
+    "maxSpec" real, 
+    "minSpec" real, 
+    "avgSpec" real,
+    PRIMARY KEY ("id"),
+    FOREIGN KEY ("idSat") REFERENCES "satellites"("id") ON DELETE NO ACTION ON UPDATE NO ACTION
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS ingest_runs;
\ No newline at end of file
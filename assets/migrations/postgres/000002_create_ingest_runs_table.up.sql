@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS "ingest_runs" ( 
+    "id" serial NOT NULL,
+    "filename" varchar(32), 
+    "satellites" int NOT NULL, 
+    "measurements" int NOT NULL, 
+    "computations" int NOT NULL, 
+    "created" timestamp NOT NULL,
+    PRIMARY KEY ("id")
+);
@@ -0,0 +1,5 @@
+ALTER TABLE "measurements" ALTER COLUMN "timestamp" TYPE varchar(32)
+    USING to_char("timestamp" AT TIME ZONE 'UTC', 'YYYY-MM-DD HH24:MI:SS') || ' +0000 UTC';
+
+ALTER TABLE "computations" ALTER COLUMN "duration" TYPE varchar(32)
+    USING ("duration" / 1000000000) || 's';
@@ -0,0 +1,14 @@
+-- timestamps were stored as time.Time.String(), e.g. "2016-02-20 15:19:00 +0000 UTC"
+ALTER TABLE "measurements" ALTER COLUMN "timestamp" TYPE timestamp(6) with time zone
+    USING (split_part("timestamp", ' ', 1) || ' ' || split_part("timestamp", ' ', 2) || ' ' || split_part("timestamp", ' ', 3))::timestamptz;
+
+-- durations were stored as time.Duration.String(), e.g. "1h2m30.5s" or "1.5ms",
+-- convert them to nanoseconds
+ALTER TABLE "computations" ALTER COLUMN "duration" TYPE bigint USING round(CASE
+    WHEN "duration" LIKE '%ns' THEN substring("duration" from '^([0-9.]+)')::numeric
+    WHEN "duration" LIKE '%us' OR "duration" LIKE '%µs' THEN substring("duration" from '^([0-9.]+)')::numeric * 1000
+    WHEN "duration" LIKE '%ms' THEN substring("duration" from '^([0-9.]+)')::numeric * 1000000
+    ELSE (coalesce(substring("duration" from '([0-9.]+)h'), '0')::numeric * 3600
+        + coalesce(substring("duration" from '([0-9.]+)m'), '0')::numeric * 60
+        + coalesce(substring("duration" from '([0-9.]+)s$'), '0')::numeric) * 1000000000
+END)::bigint;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS "measurements_timestamp_id";
@@ -0,0 +1 @@
+ALTER TABLE "satellites" ADD COLUMN "deleted" timestamptz(6);
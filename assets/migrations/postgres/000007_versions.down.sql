@@ -0,0 +1,2 @@
+ALTER TABLE "measurements" DROP COLUMN "version";
+ALTER TABLE "computations" DROP COLUMN "version";
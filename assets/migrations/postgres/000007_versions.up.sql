@@ -0,0 +1,2 @@
+ALTER TABLE "measurements" ADD COLUMN "version" integer NOT NULL DEFAULT 1;
+ALTER TABLE "computations" ADD COLUMN "version" integer NOT NULL DEFAULT 1;
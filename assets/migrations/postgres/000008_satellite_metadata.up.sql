@@ -0,0 +1,15 @@
+ALTER TABLE "satellites"
+    ADD COLUMN "satType" varchar(8) NOT NULL DEFAULT '',
+    ADD COLUMN "description" varchar(1024) NOT NULL DEFAULT '',
+    ADD COLUMN "mission" varchar(64) NOT NULL DEFAULT '',
+    ADD COLUMN "operator" varchar(64) NOT NULL DEFAULT '',
+    ADD COLUMN "launchDate" date,
+    ADD COLUMN "status" varchar(16) NOT NULL DEFAULT 'active';
+
+CREATE TABLE IF NOT EXISTS "satellite_tags" (
+    "idSat" integer NOT NULL REFERENCES "satellites"("id") ON DELETE CASCADE,
+    "tag" varchar(64) NOT NULL,
+    PRIMARY KEY ("idSat", "tag")
+);
+
+CREATE INDEX "satellite_tags_tag" ON "satellite_tags" ("tag");
@@ -0,0 +1,17 @@
+ALTER TABLE "measurements"
+    ALTER COLUMN "ionoIndex" TYPE real,
+    ALTER COLUMN "ndviIndex" TYPE real,
+    ALTER COLUMN "radiationIndex" TYPE real;
+ALTER TABLE "computations"
+    ALTER COLUMN "maxIono" TYPE real,
+    ALTER COLUMN "minIono" TYPE real,
+    ALTER COLUMN "avgIono" TYPE real,
+    ALTER COLUMN "maxNdvi" TYPE real,
+    ALTER COLUMN "minNdvi" TYPE real,
+    ALTER COLUMN "avgNdvi" TYPE real,
+    ALTER COLUMN "maxRad" TYPE real,
+    ALTER COLUMN "minRad" TYPE real,
+    ALTER COLUMN "avgRad" TYPE real,
+    ALTER COLUMN "maxSpec" TYPE real,
+    ALTER COLUMN "minSpec" TYPE real,
+    ALTER COLUMN "avgSpec" TYPE real;
@@ -0,0 +1,17 @@
+ALTER TABLE "measurements"
+    ALTER COLUMN "ionoIndex" TYPE double precision,
+    ALTER COLUMN "ndviIndex" TYPE double precision,
+    ALTER COLUMN "radiationIndex" TYPE double precision;
+ALTER TABLE "computations"
+    ALTER COLUMN "maxIono" TYPE double precision,
+    ALTER COLUMN "minIono" TYPE double precision,
+    ALTER COLUMN "avgIono" TYPE double precision,
+    ALTER COLUMN "maxNdvi" TYPE double precision,
+    ALTER COLUMN "minNdvi" TYPE double precision,
+    ALTER COLUMN "avgNdvi" TYPE double precision,
+    ALTER COLUMN "maxRad" TYPE double precision,
+    ALTER COLUMN "minRad" TYPE double precision,
+    ALTER COLUMN "avgRad" TYPE double precision,
+    ALTER COLUMN "maxSpec" TYPE double precision,
+    ALTER COLUMN "minSpec" TYPE double precision,
+    ALTER COLUMN "avgSpec" TYPE double precision;
@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS `satellites` ( 
+    `id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, 
+    `name` varchar(32) NOT NULL UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS `measurements` ( 
+    `id` integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+    `filename` varchar(32), 
+    `idSat` int NOT NULL, 
+    `timestamp` varchar(32), 
+    `ionoIndex` float, 
+    `ndviIndex` float, 
+    `radiationIndex` float, 
+    `specificMeasurement` varchar(32),
+    FOREIGN KEY (`idSat`) REFERENCES `satellites`(`id`) ON DELETE NO ACTION ON UPDATE NO ACTION
+);
+
+CREATE TABLE IF NOT EXISTS `computations` ( 
+    `id` integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+    `idSat` int NOT NULL UNIQUE, 
+    `duration` varchar(32), 
+    `maxIono` float, 
+    `minIono` float, 
+    `avgIono` float, 
+    `maxNdvi` float, 
+    `minNdvi` float, 
+    `avgNdvi` float, 
+    `maxRad` float, 
+    `minRad` float, 
+    `avgRad` float, 
+    `maxSpec` float, 
+    `minSpec` float, 
+    `avgSpec` float,
+    FOREIGN KEY (`idSat`) REFERENCES `satellites`(`id`) ON DELETE NO ACTION ON UPDATE NO ACTION
+);
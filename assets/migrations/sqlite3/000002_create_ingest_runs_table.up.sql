@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS `ingest_runs` ( 
+    `id` integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+    `filename` varchar(32), 
+    `satellites` int NOT NULL, 
+    `measurements` int NOT NULL, 
+    `computations` int NOT NULL, 
+    `created` datetime NOT NULL
+);
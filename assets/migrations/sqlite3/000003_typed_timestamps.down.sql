@@ -0,0 +1,47 @@
+CREATE TABLE `measurements_old` ( 
+    `id` integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+    `filename` varchar(32), 
+    `idSat` int NOT NULL, 
+    `timestamp` varchar(32), 
+    `ionoIndex` float, 
+    `ndviIndex` float, 
+    `radiationIndex` float, 
+    `specificMeasurement` varchar(32),
+    FOREIGN KEY (`idSat`) REFERENCES `satellites`(`id`) ON DELETE NO ACTION ON UPDATE NO ACTION
+);
+
+INSERT INTO `measurements_old` (`id`, `filename`, `idSat`, `timestamp`, `ionoIndex`, `ndviIndex`, `radiationIndex`, `specificMeasurement`)
+SELECT `id`, `filename`, `idSat`, strftime('%Y-%m-%d %H:%M:%S', `timestamp`) || ' +0000 UTC',
+    `ionoIndex`, `ndviIndex`, `radiationIndex`, `specificMeasurement`
+FROM `measurements`;
+
+DROP TABLE `measurements`;
+ALTER TABLE `measurements_old` RENAME TO `measurements`;
+
+CREATE TABLE `computations_old` ( 
+    `id` integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+    `idSat` int NOT NULL UNIQUE, 
+    `duration` varchar(32), 
+    `maxIono` float, 
+    `minIono` float, 
+    `avgIono` float, 
+    `maxNdvi` float, 
+    `minNdvi` float, 
+    `avgNdvi` float, 
+    `maxRad` float, 
+    `minRad` float, 
+    `avgRad` float, 
+    `maxSpec` float, 
+    `minSpec` float, 
+    `avgSpec` float,
+    FOREIGN KEY (`idSat`) REFERENCES `satellites`(`id`) ON DELETE NO ACTION ON UPDATE NO ACTION
+);
+
+INSERT INTO `computations_old`
+SELECT `id`, `idSat`, (`duration` / 1000000000) || 's',
+    `maxIono`, `minIono`, `avgIono`, `maxNdvi`, `minNdvi`, `avgNdvi`,
+    `maxRad`, `minRad`, `avgRad`, `maxSpec`, `minSpec`, `avgSpec`
+FROM `computations`;
+
+DROP TABLE `computations`;
+ALTER TABLE `computations_old` RENAME TO `computations`;
@@ -0,0 +1,62 @@
+-- SQLite can not change column types, the tables are copied instead.
+-- Timestamps were stored as time.Time.String(), e.g. "2016-02-20 15:19:00 +0000 UTC",
+-- and are converted to UTC in the format the sqlite3 driver writes.
+CREATE TABLE `measurements_new` ( 
+    `id` integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+    `filename` varchar(32), 
+    `idSat` int NOT NULL, 
+    `timestamp` datetime, 
+    `ionoIndex` float, 
+    `ndviIndex` float, 
+    `radiationIndex` float, 
+    `specificMeasurement` varchar(32),
+    FOREIGN KEY (`idSat`) REFERENCES `satellites`(`id`) ON DELETE NO ACTION ON UPDATE NO ACTION
+);
+
+INSERT INTO `measurements_new` (`id`, `filename`, `idSat`, `timestamp`, `ionoIndex`, `ndviIndex`, `radiationIndex`, `specificMeasurement`)
+SELECT `id`, `filename`, `idSat`,
+    strftime('%Y-%m-%d %H:%M:%S', substr(`timestamp`, 1, 19),
+        printf('%d minutes', -CAST(substr(`zone`, 1, 1) || '1' AS INTEGER) * (CAST(substr(`zone`, 2, 2) AS INTEGER) * 60 + CAST(substr(`zone`, 4, 2) AS INTEGER)))) || '+00:00',
+    `ionoIndex`, `ndviIndex`, `radiationIndex`, `specificMeasurement`
+FROM (SELECT *, substr(`timestamp`, instr(substr(`timestamp`, 12), ' ') + 12, 5) AS `zone` FROM `measurements`);
+
+DROP TABLE `measurements`;
+ALTER TABLE `measurements_new` RENAME TO `measurements`;
+
+-- Durations were stored as time.Duration.String(), e.g. "1h2m30.5s" or "1.5ms",
+-- and are converted to nanoseconds. CAST reads the number a string starts with.
+CREATE TABLE `computations_new` ( 
+    `id` integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+    `idSat` int NOT NULL UNIQUE, 
+    `duration` bigint, 
+    `maxIono` float, 
+    `minIono` float, 
+    `avgIono` float, 
+    `maxNdvi` float, 
+    `minNdvi` float, 
+    `avgNdvi` float, 
+    `maxRad` float, 
+    `minRad` float, 
+    `avgRad` float, 
+    `maxSpec` float, 
+    `minSpec` float, 
+    `avgSpec` float,
+    FOREIGN KEY (`idSat`) REFERENCES `satellites`(`id`) ON DELETE NO ACTION ON UPDATE NO ACTION
+);
+
+INSERT INTO `computations_new`
+SELECT `id`, `idSat`,
+    CAST(round(CASE
+        WHEN `duration` LIKE '%ns' THEN CAST(`duration` AS REAL)
+        WHEN `duration` LIKE '%us' OR `duration` LIKE '%µs' THEN CAST(`duration` AS REAL) * 1000
+        WHEN `duration` LIKE '%ms' THEN CAST(`duration` AS REAL) * 1000000
+        ELSE ((CASE WHEN instr(`duration`, 'h') > 0 THEN CAST(`duration` AS REAL) ELSE 0 END) * 3600
+            + (CASE WHEN instr(`minutes`, 'm') > 0 THEN CAST(`minutes` AS REAL) ELSE 0 END) * 60
+            + CAST(substr(`minutes`, instr(`minutes`, 'm') + 1) AS REAL)) * 1000000000
+    END) AS INTEGER),
+    `maxIono`, `minIono`, `avgIono`, `maxNdvi`, `minNdvi`, `avgNdvi`,
+    `maxRad`, `minRad`, `avgRad`, `maxSpec`, `minSpec`, `avgSpec`
+FROM (SELECT *, substr(`duration`, instr(`duration`, 'h') + 1) AS `minutes` FROM `computations`);
+
+DROP TABLE `computations`;
+ALTER TABLE `computations_new` RENAME TO `computations`;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS `measurements_idSat_timestamp`;
@@ -0,0 +1 @@
+CREATE INDEX `measurements_idSat_timestamp` ON `measurements` (`idSat`, `timestamp`);
@@ -0,0 +1 @@
+CREATE INDEX `measurements_timestamp_id` ON `measurements` (`timestamp`, `id`);
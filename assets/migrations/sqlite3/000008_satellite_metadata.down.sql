@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS `satellite_tags`;
+
+ALTER TABLE `satellites` DROP COLUMN `satType`;
+ALTER TABLE `satellites` DROP COLUMN `description`;
+ALTER TABLE `satellites` DROP COLUMN `mission`;
+ALTER TABLE `satellites` DROP COLUMN `operator`;
+ALTER TABLE `satellites` DROP COLUMN `launchDate`;
+ALTER TABLE `satellites` DROP COLUMN `status`;
@@ -0,0 +1,15 @@
+ALTER TABLE `satellites` ADD COLUMN `satType` varchar(8) NOT NULL DEFAULT '';
+ALTER TABLE `satellites` ADD COLUMN `description` varchar(1024) NOT NULL DEFAULT '';
+ALTER TABLE `satellites` ADD COLUMN `mission` varchar(64) NOT NULL DEFAULT '';
+ALTER TABLE `satellites` ADD COLUMN `operator` varchar(64) NOT NULL DEFAULT '';
+ALTER TABLE `satellites` ADD COLUMN `launchDate` date;
+ALTER TABLE `satellites` ADD COLUMN `status` varchar(16) NOT NULL DEFAULT 'active';
+
+CREATE TABLE IF NOT EXISTS `satellite_tags` (
+    `idSat` int NOT NULL,
+    `tag` varchar(64) NOT NULL,
+    PRIMARY KEY (`idSat`, `tag`),
+    FOREIGN KEY (`idSat`) REFERENCES `satellites`(`id`) ON DELETE CASCADE ON UPDATE NO ACTION
+);
+
+CREATE INDEX `satellite_tags_tag` ON `satellite_tags` (`tag`);
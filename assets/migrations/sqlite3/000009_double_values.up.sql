@@ -0,0 +1,3 @@
+-- SQLite stores float columns as 8-byte REAL already, the version only keeps
+-- the dialects in step.
+SELECT 1;
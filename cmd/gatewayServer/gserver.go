@@ -2,183 +2,144 @@ package main
 
 import (
 	"context"
-	"database/sql"
-	"errors"
 	"flag"
 	"fmt"
 	"net"
 	"net/http"
-	"strconv"
+	"os"
+	"strings"
+	"time"
 
-	"github.com/doug-martin/goqu/v9"
-	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 
 	"github.com/Simek13/satelliteApp/internal/database"
-	pb "github.com/Simek13/satelliteApp/pkg"
-	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
+	"github.com/Simek13/satelliteApp/internal/migrate"
+	"github.com/Simek13/satelliteApp/internal/server"
+	"github.com/Simek13/satelliteApp/internal/watch"
+	pbv1 "github.com/Simek13/satelliteApp/pkg/v1"
+	pb "github.com/Simek13/satelliteApp/pkg/v2"
 	log "github.com/sirupsen/logrus"
 )
 
 var cfg struct {
-	serverPort string
-
-	dbType string
-	dbUser string
-	dbPass string
-	dbHost string
-	dbPort string
-	dbName string
-}
-
-type satelliteCommunicationServer struct {
-	pb.UnimplementedSatelliteCommunicationServer
+	serverPort    string
+	autoMigrate   bool
+	watchInterval time.Duration
 
-	db *database.MySQLDatabase
+	db database.Config
 }
 
-func (s *satelliteCommunicationServer) GetMeasurements(ctx context.Context, filter *pb.SatelliteFilter) (*pb.MeasurementResponse, error) {
-	measurements, err := s.db.GetMeasurements(int(filter.GetSatId()))
-	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "Measurements for satellite: %q could not be found", filter.GetSatId())
-	}
-	pbMeasurements := make([]*pb.Measurement, 0)
-	for _, m := range measurements {
-		pbMeasurements = append(pbMeasurements, m.Protobuf())
-	}
-
-	return &pb.MeasurementResponse{Measurements: pbMeasurements}, nil
-
+func validate() (err error) {
+	return cfg.db.Validate()
 }
 
-func (s *satelliteCommunicationServer) GetComputations(ctx context.Context, filter *pb.SatelliteFilter) (*pb.ComputationResponse, error) {
-	computations, err := s.db.GetComputations(int(filter.GetSatId()))
-	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "Computations for satellite: %q could not be found. %s", filter.GetSatId(), errors.Unwrap(err))
-	}
-	pbComputations := make([]*pb.Computation, 0)
-	for _, c := range computations {
-		pbComputations = append(pbComputations, c.Protobuf())
-	}
-
-	computationResponse := &pb.ComputationResponse{Computations: pbComputations}
+func run() error {
 
-	return computationResponse, nil
-}
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
-func (s *satelliteCommunicationServer) AddSatellite(ctx context.Context, rq *pb.Satellite) (*pb.Satellite, error) {
-	fmt.Println("Server side, adding satellite:", rq.Name)
-	satellite := database.NewSatellite(rq)
-	err := s.db.AddSatellite(satellite)
+	conn, err := grpc.DialContext(ctx, "localhost"+cfg.serverPort, grpc.WithInsecure())
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Cannot add given satellite: %v", err)
+		return err
 	}
+	defer conn.Close()
 
-	return satellite.Protobuf(), nil
-}
-
-func (s *satelliteCommunicationServer) AddMeasurement(ctx context.Context, rq *pb.Measurement) (*pb.Measurement, error) {
-	fmt.Println(rq)
-	measurement := database.NewMeasurement(rq)
-	err := s.db.AddMeasurement(measurement)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Cannot add given measurement: %v", err)
+	// both API versions are served side by side, their routes are prefixed
+	// with the version
+	gwmux := server.NewGatewayMux()
+	if err := pbv1.RegisterSatelliteCommunicationHandler(ctx, gwmux, conn); err != nil {
+		return err
 	}
-
-	return measurement.Protobuf(), nil
-}
-
-func (s *satelliteCommunicationServer) AddComputation(ctx context.Context, rq *pb.Computation) (*pb.Computation, error) {
-	computation := database.NewComputation(rq)
-	err := s.db.AddComputation(computation)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Cannot add given computation")
+	if err := pb.RegisterSatelliteCommunicationHandler(ctx, gwmux, conn); err != nil {
+		return err
 	}
+	mux := http.NewServeMux()
+	mux.Handle("/v1/ingest", server.V1IngestHandler(gwmux, pbv1.NewSatelliteCommunicationClient(conn)))
+	mux.Handle("/v2/ingest", server.IngestHandler(gwmux, pb.NewSatelliteCommunicationClient(conn)))
+	mux.Handle("/", gwmux)
+	return http.ListenAndServe(":9090", mux)
+}
 
-	return computation.Protobuf(), nil
+// dbFlags registers the database connection flags on fs.
+func dbFlags(fs *flag.FlagSet) {
+	fs.StringVar(&cfg.db.Type, "db_type", database.MySQL, "type of database: "+strings.Join(database.Types, ", "))
+	fs.StringVar(&cfg.db.User, "db_user", "root", "user name for database")
+	fs.StringVar(&cfg.db.Pass, "db_pass", "emis", "user password for database")
+	fs.StringVar(&cfg.db.Host, "db_host", "127.0.0.1", "host for database")
+	fs.StringVar(&cfg.db.Port, "db_port", "3306", "port for database connection")
+	fs.StringVar(&cfg.db.Name, "db_name", "satellites", "name of database, or path of the database file for sqlite3")
 }
 
-func validate() (err error) {
-	if cfg.dbType != "mysql" && cfg.dbType != "postgres" && cfg.dbType != "sqlite3" && cfg.dbType != "sqlserver" {
-		return errors.New("invalid db type")
-	}
-	if len(cfg.dbUser) < 4 || len(cfg.dbUser) > 100 {
-		return errors.New("db user is not between 4 and 100 characters")
-	}
-	if len(cfg.dbPass) < 4 || len(cfg.dbPass) > 100 {
-		return errors.New("db password is not between 4 and 100 characters")
+// runMigrate implements the "migrate" subcommand, which manages the database
+// schema with the migrations embedded in the binary.
+func runMigrate(args []string) error {
+	fs := flag.NewFlagSet("migrate", flag.ExitOnError)
+	dbFlags(fs)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: gatewayServer migrate [flags] %s\n", migrate.Commands)
+		fs.PrintDefaults()
 	}
-	if len(cfg.dbHost) < 4 || len(cfg.dbHost) > 100 {
-		return errors.New("db host is not between 4 and 100 characters")
-	}
-	var port int
-	port, err = strconv.Atoi(cfg.dbPort)
-	if err != nil {
-		port, err = net.LookupPort("tcp", cfg.dbPort)
-		if err != nil {
-			return errors.New("invalid db port")
-		}
-	}
-	if port < 1024 || port > 65535 {
-		return errors.New("db port is not between 1024 and 65535")
-	}
-	if len(cfg.dbName) < 4 || len(cfg.dbName) > 100 {
-		return errors.New("db name is not between 4 and 100 characters")
+	if err := fs.Parse(args); err != nil {
+		return err
 	}
-
-	return nil
-}
-
-func run() error {
-
-	ctx := context.Background()
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-
-	opts := []grpc.DialOption{grpc.WithInsecure()}
-	gwmux := runtime.NewServeMux()
-	err := pb.RegisterSatelliteCommunicationHandlerFromEndpoint(ctx, gwmux, "localhost"+cfg.serverPort, opts)
-	if err != nil {
+	if err := cfg.db.Validate(); err != nil {
 		return err
 	}
-	return http.ListenAndServe(":9090", gwmux)
+	return migrate.Run(cfg.db, fs.Args(), os.Stdout)
 }
 
 func main() {
 	ctxlog := log.WithFields(log.Fields{"event": "server"})
+
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(os.Args[2:]); err != nil {
+			ctxlog.WithFields(log.Fields{"status": "failed", "error": err}).Fatal("Error migrating database")
+		}
+		return
+	}
+
 	flag.StringVar(&cfg.serverPort, "grpc-server-port", ":8080", "gRPC server port")
-	flag.StringVar(&cfg.dbType, "db_type", "mysql", "type of database")
-	flag.StringVar(&cfg.dbUser, "db_user", "root", "user name for database")
-	flag.StringVar(&cfg.dbPass, "db_pass", "emis", "user password for database")
-	flag.StringVar(&cfg.dbHost, "db_host", "127.0.0.1", "host for database")
-	flag.StringVar(&cfg.dbPort, "db_port", "3306", "port for database connection")
-	flag.StringVar(&cfg.dbName, "db_name", "satellites", "name of database")
+	flag.BoolVar(&cfg.autoMigrate, "auto-migrate", false, "apply pending schema migrations at startup")
+	flag.DurationVar(&cfg.watchInterval, "watch-interval", watch.DefaultInterval, "how often watched measurements stored by other processes are looked up")
+	dbFlags(flag.CommandLine)
+	flag.Parse()
 
 	err := validate()
 	if err != nil {
 		ctxlog.WithFields(log.Fields{"status": "failed", "error": err}).Fatal(err)
 	}
 
-	dbBaseUrl := fmt.Sprintf("%s:%s@tcp(%s:%s)/", cfg.dbUser, cfg.dbPass, cfg.dbHost, cfg.dbPort)
-	dbUrl := dbBaseUrl + cfg.dbName
-	db, err := sql.Open(cfg.dbType, dbUrl)
+	db, err := database.Open(cfg.db)
 	if err != nil {
 		ctxlog.WithFields(log.Fields{"status": "failed", "error": err}).Fatal("Error opening database")
 	}
-	gdb := goqu.New(cfg.dbType, db)
-	mysqlDb := &database.MySQLDatabase{gdb}
-
-	fmt.Println("Successfully Connected to MySQL database")
 	defer db.Close()
 
+	ctxlog.WithFields(log.Fields{"status": "success", "db_type": cfg.db.Type}).Info("Successfully connected to database")
+
+	if err := migrate.Startup(db, cfg.autoMigrate); err != nil {
+		ctxlog.WithFields(log.Fields{"status": "failed", "error": err}).Fatal("Error checking database schema")
+	}
+
 	lis, err := net.Listen("tcp", cfg.serverPort)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
+	hub, err := watch.NewHub(db, cfg.watchInterval)
+	if err != nil {
+		ctxlog.WithFields(log.Fields{"status": "failed", "error": err}).Fatal("Error watching measurements")
+	}
+	go func() {
+		if err := hub.Run(context.Background()); err != nil {
+			ctxlog.WithFields(log.Fields{"status": "failed", "error": err}).Fatal("Error watching measurements")
+		}
+	}()
+
 	s := grpc.NewServer()
-	pb.RegisterSatelliteCommunicationServer(s, &satelliteCommunicationServer{db: mysqlDb})
+	srv := server.New(db, hub)
+	pbv1.RegisterSatelliteCommunicationServer(s, server.NewV1(srv))
+	pb.RegisterSatelliteCommunicationServer(s, srv)
 	log.Printf("Serving gRPC on localhost%s", cfg.serverPort)
 	go func() {
 		if err := s.Serve(lis); err != nil {
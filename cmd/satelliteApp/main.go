@@ -1,113 +1,142 @@
 package main
 
 import (
-	"database/sql"
-	"fmt"
-	"net"
-	"strconv"
+	"context"
+	"os"
+	"os/signal"
 	"strings"
 
 	"github.com/Simek13/satelliteApp/internal/app"
-	"github.com/Simek13/satelliteApp/internal/csv"
 	"github.com/Simek13/satelliteApp/internal/database"
+	"github.com/Simek13/satelliteApp/internal/migrate"
+	"github.com/Simek13/satelliteApp/internal/print"
 
-	"github.com/doug-martin/goqu/v9"
 	"github.com/namsral/flag"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
-
-	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
 )
 
+const defaultCsvUrl = "https://raw.githubusercontent.com/sea43d/PythonEvaluation/master/satDataCSV2.csv"
+
 // TODO napravi cfg strukturu kao u sunspotu i koristi flagove za neke ulazne parametre kao ime baze i ip adresa, lokacija filea, itd...
 var cfg struct {
 	inputCsvUrl string
+	output      string
+	chart       string
+	noDb        bool
+	dryRun      bool
+	autoMigrate bool
 
 	// DB flags
-	dbType string
-	dbUser string
-	dbPass string
-	dbHost string
-	dbPort string
-	dbName string
+	db        database.Config
+	batchSize int
 }
 
 func validate() (err error) {
-	if cfg.dbType != "mysql" && cfg.dbType != "postgres" && cfg.dbType != "sqlite3" && cfg.dbType != "sqlserver" {
-		return errors.New("Invalid db type")
-	}
-	if len(cfg.dbUser) < 4 || len(cfg.dbUser) > 100 {
-		return errors.New("db user is not between 4 and 100 characters")
-	}
-	if len(cfg.dbPass) < 4 || len(cfg.dbPass) > 100 {
-		return errors.New("db password is not between 4 and 100 characters")
+	if _, err = print.NewRenderer(cfg.output); err != nil {
+		return errors.Errorf("output must be one of %s", strings.Join(print.Formats, ", "))
 	}
-	if len(cfg.dbHost) < 4 || len(cfg.dbHost) > 100 {
-		return errors.New("db host is not between 4 and 100 characters")
+	if cfg.chart != print.ChartNone && cfg.chart != print.ChartBar && cfg.chart != print.ChartLine {
+		return errors.Errorf("chart must be one of %s", strings.Join(print.ChartModes, ", "))
 	}
-	var port int
-	port, err = strconv.Atoi(cfg.dbPort)
-	if err != nil {
-		port, err = net.LookupPort("tcp", cfg.dbPort)
-		if err != nil {
-			return errors.New("Invalid db port")
-		}
+	if cfg.noDb || cfg.dryRun {
+		return nil
 	}
-	if port < 1024 || port > 65535 {
-		return errors.New("db port is not between 1024 and 65535")
+	if err = cfg.db.Validate(); err != nil {
+		return err
 	}
-	if len(cfg.dbName) < 4 || len(cfg.dbName) > 100 {
-		return errors.New("db name is not between 4 and 100 characters")
+	if cfg.batchSize < 1 || cfg.batchSize > 10000 {
+		return errors.New("batch size is not between 1 and 10000")
 	}
 
 	return nil
 }
 
+// dbFlags registers the database connection flags on fs.
+func dbFlags(fs *flag.FlagSet) {
+	fs.StringVar(&cfg.db.Type, "db_type", database.MySQL, "type of database: "+strings.Join(database.Types, ", "))
+	fs.StringVar(&cfg.db.User, "db_user", "root", "user name for database")
+	fs.StringVar(&cfg.db.Pass, "db_pass", "emis", "user password for database")
+	fs.StringVar(&cfg.db.Host, "db_host", "127.0.0.1", "host for database")
+	fs.StringVar(&cfg.db.Port, "db_port", "3306", "port for database connection")
+	fs.StringVar(&cfg.db.Name, "db_name", "satellites", "name of database, or path of the database file for sqlite3")
+}
+
 func main() {
 	ctxlog := log.WithFields(log.Fields{"event": "main"})
-	flag.StringVar(&cfg.inputCsvUrl, "url", "https://raw.githubusercontent.com/sea43d/PythonEvaluation/master/satDataCSV2.csv", "url of input csv file")
-	flag.StringVar(&cfg.dbType, "db_type", "mysql", "type of database")
-	flag.StringVar(&cfg.dbUser, "db_user", "root", "user name for database")
-	flag.StringVar(&cfg.dbPass, "db_pass", "emis", "user password for database")
-	flag.StringVar(&cfg.dbHost, "db_host", "127.0.0.1", "host for database")
-	flag.StringVar(&cfg.dbPort, "db_port", "3306", "port for database connection")
-	flag.StringVar(&cfg.dbName, "db_name", "satellites", "name of database")
+
+	if len(os.Args) > 1 && os.Args[1] == "report" {
+		if err := runReport(os.Args[2:]); err != nil {
+			ctxlog.WithFields(log.Fields{"status": "failed", "error": err}).Fatal("Error creating report")
+		}
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(os.Args[2:]); err != nil {
+			ctxlog.WithFields(log.Fields{"status": "failed", "error": err}).Fatal("Error migrating database")
+		}
+		return
+	}
+
+	flag.StringVar(&cfg.inputCsvUrl, "url", defaultCsvUrl, "url of input csv file")
+	flag.StringVar(&cfg.output, "output", print.FormatText, "report output format: "+strings.Join(print.Formats, ", "))
+	flag.StringVar(&cfg.chart, "chart", print.ChartNone, "draw averages rankings as charts in text output: "+strings.Join(print.ChartModes, ", "))
+	flag.BoolVar(&cfg.noDb, "no-db", false, "do not connect to the database and skip persisting")
+	flag.BoolVar(&cfg.dryRun, "dry-run", false, "log what would be persisted instead of writing it to the database")
+	flag.BoolVar(&cfg.autoMigrate, "auto-migrate", false, "apply pending schema migrations before writing to the database")
+	dbFlags(flag.CommandLine)
+	flag.IntVar(&cfg.batchSize, "batch_size", database.DefaultBatchSize, "number of measurements written per insert statement")
+	flag.Parse()
 
 	err := validate()
 	if err != nil {
 		ctxlog.WithFields(log.Fields{"status": "failed", "error": err}).Fatal(err)
 	}
 
-	split := strings.Split(cfg.inputCsvUrl, "/")
-	filename := split[len(split)-1]
-	data, err := csv.ReadCsvFromUrl(cfg.inputCsvUrl)
-	if err != nil {
-		ctxlog.WithFields(log.Fields{"status": "failed", "error": err}).Fatal("Error reading csv")
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	renderer, _ := print.NewRenderer(cfg.output)
+	if t, ok := renderer.(*print.TextRenderer); ok {
+		width, tty := print.Terminal(os.Stdout)
+		t.Width = width
+		t.Sparklines = tty
+		if tty {
+			t.Chart = cfg.chart
+		} else if cfg.chart != print.ChartNone {
+			ctxlog.Info("Output is not a terminal, printing rankings without charts")
+		}
 	}
 
-	sats, err := csv.ParseCsvData(data)
-	if err != nil {
-		ctxlog.WithFields(log.Fields{"status": "failed", "error": err}).Fatal("Error parsing csv data")
+	pipeline := &app.Pipeline{
+		Loader:    app.CsvLoader{Url: cfg.inputCsvUrl},
+		Validator: app.BatchValidator{},
+		Computer:  app.ReportComputer{},
+		Reporter:  app.RenderReporter{Renderer: renderer, Writer: os.Stdout},
 	}
 
-	dbBaseUrl := fmt.Sprintf("%s:%s@tcp(%s:%s)/", cfg.dbUser, cfg.dbPass, cfg.dbHost, cfg.dbPort)
-	// create db
-	/* db, err := database.Create(dbBaseUrl, cfg.dbName, cfg.dbType)
-	if err != nil {
-		fmt.Println("error in db while checking: %w", err)
-	} */
+	switch {
+	case cfg.noDb:
+	case cfg.dryRun:
+		pipeline.Persister = app.DryRunPersister{}
+	default:
+		db, err := database.Open(cfg.db)
+		if err != nil {
+			ctxlog.WithFields(log.Fields{"status": "failed", "error": err}).Fatal("Error opening database")
+		}
+		defer db.Close()
+		db.BatchSize = cfg.batchSize
+		if err := migrate.Startup(db, cfg.autoMigrate); err != nil {
+			ctxlog.WithFields(log.Fields{"status": "failed", "error": err}).Fatal("Error checking database schema")
+		}
 
-	dbUrl := dbBaseUrl + cfg.dbName
-	db, err := sql.Open(cfg.dbType, dbUrl)
-	if err != nil {
-		ctxlog.WithFields(log.Fields{"status": "failed", "error": err}).Fatal("Error opening database")
+		ctxlog.WithFields(log.Fields{"status": "success", "db_type": cfg.db.Type}).Info("Successfully connected to database")
+		pipeline.Validator = app.BatchValidator{Satellites: db}
+		pipeline.Persister = app.DBPersister{Db: db}
 	}
-	gdb := goqu.New(cfg.dbType, db)
-	mysqlDb := &database.MySQLDatabase{gdb}
-
-	fmt.Println("Successfully Connected to MySQL database")
-	defer db.Close()
-
-	app.Run(filename, sats, mysqlDb)
 
+	if _, err := pipeline.Run(ctx); err != nil {
+		ctxlog.WithFields(log.Fields{"status": "failed", "error": err}).Error("Error running pipeline")
+		os.Exit(1)
+	}
 }
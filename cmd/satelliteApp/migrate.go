@@ -0,0 +1,28 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/Simek13/satelliteApp/internal/migrate"
+
+	"github.com/namsral/flag"
+)
+
+// runMigrate implements the "migrate" subcommand, which manages the database
+// schema with the migrations embedded in the binary.
+func runMigrate(args []string) error {
+	fs := flag.NewFlagSet("migrate", flag.ExitOnError)
+	dbFlags(fs)
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: satelliteApp migrate [flags] %s\n", migrate.Commands)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := cfg.db.Validate(); err != nil {
+		return err
+	}
+	return migrate.Run(cfg.db, fs.Args(), os.Stdout)
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/Simek13/satelliteApp/internal/app"
+	"github.com/Simek13/satelliteApp/internal/print"
+
+	"github.com/namsral/flag"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// runReport implements the "report" subcommand, which writes a standalone
+// html summary of the input file without touching the database.
+func runReport(args []string) error {
+	var htmlPath string
+	fs := flag.NewFlagSet("report", flag.ExitOnError)
+	fs.StringVar(&cfg.inputCsvUrl, "url", defaultCsvUrl, "url of input csv file")
+	fs.StringVar(&htmlPath, "html", "", "path of the html report to write")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if htmlPath == "" {
+		return errors.New("html report path is required")
+	}
+
+	f, err := os.Create(htmlPath)
+	if err != nil {
+		return errors.Wrap(err, "Error creating html report")
+	}
+	defer f.Close()
+
+	pipeline := &app.Pipeline{
+		Loader:    app.CsvLoader{Url: cfg.inputCsvUrl},
+		Validator: app.BatchValidator{},
+		Computer:  app.ReportComputer{},
+		Reporter:  app.RenderReporter{Renderer: print.HTMLRenderer{Generated: time.Now()}, Writer: f},
+	}
+	if _, err := pipeline.Run(context.Background()); err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrap(err, "Error writing html report")
+	}
+
+	log.WithFields(log.Fields{"event": "report", "status": "success", "file": htmlPath}).Info("Successfully written html report")
+	return nil
+}
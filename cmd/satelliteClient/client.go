@@ -3,12 +3,18 @@ package main
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"time"
 
+	"github.com/Simek13/satelliteApp/internal/csv"
 	"github.com/Simek13/satelliteApp/internal/database"
+	"github.com/Simek13/satelliteApp/internal/server"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
-	pb "github.com/Simek13/satelliteApp/pkg"
+	pb "github.com/Simek13/satelliteApp/pkg/v2"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/namsral/flag"
@@ -90,9 +96,80 @@ func addComputation(client pb.SatelliteCommunicationClient, c *pb.Computation) e
 	return nil
 }
 
+// uploadCsv uploads the measurements of a local CSV file through
+// UploadMeasurements, adding its satellites first.
+func uploadCsv(client pb.SatelliteCommunicationClient, path string) error {
+	rows, err := csv.ReadCsvFile(path)
+	if err != nil {
+		return err
+	}
+	sats, err := csv.ParseCsvData(rows)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	for name, sat := range sats {
+		// fails if the satellite is already stored, satellites that are
+		// still missing are reported as rejections by the upload
+		satType := database.SatelliteTypeProtobuf(sat.GetSatellite().SatelliteType.String())
+		client.AddSatellite(ctx, &pb.Satellite{Name: name, Type: satType})
+	}
+
+	stream, err := client.UploadMeasurements(ctx)
+	if err != nil {
+		return err
+	}
+	filename := filepath.Base(path)
+	for name, sat := range sats {
+		satType := sat.GetSatellite().SatelliteType.String()
+		for _, m := range database.MeasurementsOf(filename, 0, sat) {
+			if err := stream.Send(&pb.UploadRequest{Measurement: m.Protobuf(satType), Satellite: name}); err != nil {
+				return err
+			}
+		}
+	}
+	summary, err := stream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Uploaded %s: %d measurements accepted, %d rejected, ingest run %d\n", path, summary.Accepted, len(summary.Rejected), summary.RunId)
+	for _, r := range summary.Rejected {
+		fmt.Printf("Measurement %d rejected: %s\n", r.Index, r.Reason)
+	}
+	return nil
+}
+
+// ingestCsv sends a local CSV file to IngestFile, which stores it like
+// satelliteApp does.
+func ingestCsv(client pb.SatelliteCommunicationClient, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	report, err := server.Ingest(ctx, client, filepath.Base(path), f)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Ingested %s: %d satellites, %d measurements and %d computations, ingest run %d\n",
+		path, report.Satellites, report.Measurements, report.Computations, report.RunId)
+	for _, n := range report.Notes {
+		fmt.Printf("%s: %s\n", n.Satellite, n.Message)
+	}
+	return nil
+}
+
 func main() {
 	ctxlog := log.WithFields(log.Fields{"event": "client"})
 	flag.StringVar(&cfg.serverAddr, "server address", "localhost:10000", "Address of server")
+	flag.Parse()
 
 	opts := []grpc.DialOption{grpc.WithInsecure()}
 	conn, err := grpc.Dial(cfg.serverAddr, opts...)
@@ -102,6 +179,25 @@ func main() {
 	defer conn.Close()
 	client := pb.NewSatelliteCommunicationClient(conn)
 
+	if flag.Arg(0) == "upload" {
+		if flag.NArg() != 2 {
+			ctxlog.WithFields(log.Fields{"status": "failed"}).Fatal("Usage: satelliteClient upload FILE.csv")
+		}
+		if err := uploadCsv(client, flag.Arg(1)); err != nil {
+			ctxlog.WithFields(log.Fields{"status": "failed", "error": err}).Fatal("Error uploading measurements")
+		}
+		return
+	}
+	if flag.Arg(0) == "ingest" {
+		if flag.NArg() != 2 {
+			ctxlog.WithFields(log.Fields{"status": "failed"}).Fatal("Usage: satelliteClient ingest FILE.csv")
+		}
+		if err := ingestCsv(client, flag.Arg(1)); err != nil {
+			ctxlog.WithFields(log.Fields{"status": "failed", "error": err}).Fatal("Error ingesting file")
+		}
+		return
+	}
+
 	/* err = printMeasurements(client, &pb.SatelliteFilter{SatId: 1})
 	if err != nil {
 		ctxlog.WithFields(log.Fields{"status": "failed", "error": err}).Fatal("Error printing measurements")
@@ -117,12 +213,12 @@ func main() {
 		ctxlog.WithFields(log.Fields{"status": "failed", "error": err}).Fatal("Error adding satellite")
 	}
 
-	err = addMeasurement(client, &pb.Measurement{FileName: "novi.csv", IdSat: 5, Timestamp: "15:15", IonoIndex: 2, NdviIndex: 5, RadiationIndex: 26, SpecificMeasurement: "china"})
+	err = addMeasurement(client, &pb.Measurement{FileName: "novi.csv", SatId: 5, Timestamp: timestamppb.New(time.Date(2016, 2, 20, 15, 15, 0, 0, time.UTC)), IonoIndex: 2, NdviIndex: 5, RadiationIndex: 26, Specific: &pb.Measurement_Vegetation{Vegetation: "china"}})
 	if err != nil {
 		ctxlog.WithFields(log.Fields{"status": "failed", "error": err}).Fatal("Error adding measurement")
 	}
 
-	err = addComputation(client, &pb.Computation{IdSat: 5, Duration: "30:00", MaxIono: 5, MinIono: 2, AvgIono: 3, MaxNdvi: 15, MinNdvi: 12, AvgNdvi: 13, MaxRad: 3, MinRad: 1, AvgRad: 2, MaxSpec: 0, MinSpec: 0, AvgSpec: 0})
+	err = addComputation(client, &pb.Computation{SatId: 5, Duration: durationpb.New(30 * time.Minute), MaxIono: 5, MinIono: 2, AvgIono: 3, MaxNdvi: 15, MinNdvi: 12, AvgNdvi: 13, MaxRad: 3, MinRad: 1, AvgRad: 2, MaxSpec: 0, MinSpec: 0, AvgSpec: 0})
 	if err != nil {
 		ctxlog.WithFields(log.Fields{"status": "failed", "error": err}).Fatal("Error adding computation")
 	}
@@ -1,73 +1,107 @@
 package app
 
 import (
-	"errors"
+	"context"
 	"fmt"
 
 	"github.com/Simek13/satelliteApp/internal/database"
-	"github.com/Simek13/satelliteApp/internal/print"
+	"github.com/Simek13/satelliteApp/internal/report"
 	"github.com/Simek13/satelliteApp/internal/satellites"
 
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 )
 
-func Run(filename string, sats map[string]satellites.Satellite, mysqlDb *database.MySQLDatabase) {
-	ctxlog := log.WithFields(log.Fields{"event": "main_loop"})
-
-	print.PrintSatelliteMeasurementTimes(sats)
-
-	fmt.Println()
-
-	ionoAvg := make(map[string]float64)
-	NDVIAvg := make(map[string]float64)
-	radAvg := make(map[string]float64)
-	altAvg := make(map[string]float64)
-	salAvg := make(map[string]float64)
-
-	// kako ovo raščlaniti na funkcije koje rade samo jednu stvar
-	for id, sat := range sats {
-
-		fmt.Println("Satellite: ", id)
-		ionoCalc, ndviCalc, radCalc, specCalc := sat.Compute()
-		fmt.Println("Ionosphere index:", ionoCalc[0], "(MIN)", ionoCalc[1], "(MAX)", ionoCalc[2], "(AVG)")
-		ionoAvg[id] = ionoCalc[2]
-		fmt.Println("NDVI index:", ndviCalc[0], "(MIN)", ndviCalc[1], "(MAX)", ndviCalc[2], "(AVG)")
-		NDVIAvg[id] = ndviCalc[2]
-		fmt.Println("Radiation index:", radCalc[0], "(MIN)", radCalc[1], "(MAX)", radCalc[2], "(AVG)")
-		radAvg[id] = radCalc[2]
-		switch sat.(type) {
-		case *satellites.EaSatellite:
-			fmt.Println("Earth altitude:", specCalc[0], "(MIN)", specCalc[1], "(MAX)", specCalc[2], "(AVG)")
-			altAvg[id] = specCalc[2]
-		case *satellites.SsSatellite:
-			fmt.Println("Sea salinity index:", specCalc[0], "(MIN)", specCalc[1], "(MAX)", specCalc[2], "(AVG)")
-			salAvg[id] = specCalc[2]
-		}
+// Batch is the data of one input file as it moves through the pipeline.
+type Batch struct {
+	FileName   string
+	Satellites map[string]satellites.Satellite
+	Report     *report.Report
+	// Run is the ingest run of the batch once it was persisted to a database.
+	Run *database.IngestRun
+}
 
-		fmt.Println()
+// StageError is the error of the pipeline stage Stage.
+type StageError struct {
+	Stage string
+	Err   error
+}
 
-	}
+func (e *StageError) Error() string {
+	return fmt.Sprintf("Error in %s stage: %v", e.Stage, e.Err)
+}
 
-	print.PrintSatelliteCalculationAverages(ionoAvg, NDVIAvg, radAvg, altAvg, salAvg)
+func (e *StageError) Unwrap() error {
+	return e.Err
+}
 
-	err := mysqlDb.AddSatellites(sats)
-	if err != nil {
-		ctxlog.WithFields(log.Fields{"status": "failed", "error": err}).Fatal(errors.Unwrap(err))
-	} else {
-		ctxlog.WithFields(log.Fields{"status": "success", "event": "Successfully written satellites to db."}).Info()
-	}
+type Loader interface {
+	Load(ctx context.Context) (*Batch, error)
+}
+
+type Validator interface {
+	Validate(ctx context.Context, b *Batch) error
+}
 
-	err = mysqlDb.AddMeasurements(filename, sats)
+type Computer interface {
+	Compute(ctx context.Context, b *Batch) error
+}
+
+type Reporter interface {
+	Report(ctx context.Context, b *Batch) error
+}
+
+type Persister interface {
+	Persist(ctx context.Context, b *Batch) error
+}
+
+// Pipeline runs the stages load -> validate -> compute -> report -> persist
+// in order. Every stage except the loader is optional; a nil stage is skipped.
+type Pipeline struct {
+	Loader    Loader
+	Validator Validator
+	Computer  Computer
+	Reporter  Reporter
+	Persister Persister
+}
+
+// Run executes the pipeline and returns the processed batch. It stops at the
+// first stage that fails or when ctx is done.
+func (p *Pipeline) Run(ctx context.Context) (*Batch, error) {
+	ctxlog := log.WithFields(log.Fields{"event": "pipeline"})
+
+	if p.Loader == nil {
+		return nil, errors.New("pipeline has no loader")
+	}
+	b, err := p.Loader.Load(ctx)
 	if err != nil {
-		ctxlog.WithFields(log.Fields{"status": "failed", "error": err}).Fatal(errors.Unwrap(err))
-	} else {
-		ctxlog.WithFields(log.Fields{"status": "success", "event": "Successfully written measurements to db."}).Info()
+		return nil, &StageError{Stage: "load", Err: err}
 	}
+	ctxlog.WithFields(log.Fields{"stage": "load", "status": "success", "file": b.FileName}).Debug()
 
-	err = mysqlDb.AddComputations(sats)
-	if err != nil {
-		ctxlog.WithFields(log.Fields{"status": "failed", "error": err}).Fatal(errors.Unwrap(err))
-	} else {
-		ctxlog.WithFields(log.Fields{"status": "success", "event": "Successfully written computations to db."}).Info()
+	stages := []struct {
+		name string
+		run  func() error
+		skip bool
+	}{
+		{"validate", func() error { return p.Validator.Validate(ctx, b) }, p.Validator == nil},
+		{"compute", func() error { return p.Computer.Compute(ctx, b) }, p.Computer == nil},
+		{"report", func() error { return p.Reporter.Report(ctx, b) }, p.Reporter == nil},
+		{"persist", func() error { return p.Persister.Persist(ctx, b) }, p.Persister == nil},
+	}
+	for _, s := range stages {
+		if err := ctx.Err(); err != nil {
+			return b, err
+		}
+		if s.skip {
+			ctxlog.WithFields(log.Fields{"stage": s.name, "status": "skipped"}).Debug()
+			continue
+		}
+		if err := s.run(); err != nil {
+			return b, &StageError{Stage: s.name, Err: err}
+		}
+		ctxlog.WithFields(log.Fields{"stage": s.name, "status": "success"}).Debug()
 	}
+
+	return b, nil
 }
@@ -0,0 +1,178 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Simek13/satelliteApp/internal/database/memory"
+	"github.com/Simek13/satelliteApp/internal/print"
+	"github.com/Simek13/satelliteApp/internal/satellites"
+)
+
+type recordingPersister struct {
+	calls int
+	err   error
+}
+
+func (p *recordingPersister) Persist(ctx context.Context, b *Batch) error {
+	p.calls++
+	return p.err
+}
+
+func testSatellites() map[string]satellites.Satellite {
+	return map[string]satellites.Satellite{
+		"8J14": &satellites.VcSatellite{
+			BasicSatellite: satellites.BasicSatellite{
+				Id:               "8J14",
+				Timestamps:       []time.Time{time.Date(2016, 02, 20, 15, 34, 0, 0, time.UTC)},
+				IonoIndexes:      []float64{10},
+				NdviIndexes:      []float64{49},
+				RadiationIndexes: []float64{41},
+				SatelliteType:    satellites.Vc,
+			},
+			Vegetations: []string{"WOODS"},
+		},
+	}
+}
+
+func TestPipelineRun(t *testing.T) {
+	var buf bytes.Buffer
+	persister := &recordingPersister{}
+	p := &Pipeline{
+		Loader:    StaticLoader{"test.csv", testSatellites()},
+		Validator: BatchValidator{},
+		Computer:  ReportComputer{},
+		Reporter:  RenderReporter{Renderer: &print.TextRenderer{}, Writer: &buf},
+		Persister: persister,
+	}
+
+	b, err := p.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if b.Report == nil || len(b.Report.Satellites) != 1 {
+		t.Errorf("Run() report = %v, want report of 1 satellite", b.Report)
+	}
+	if buf.Len() == 0 {
+		t.Errorf("Run() did not render the report")
+	}
+	if persister.calls != 1 {
+		t.Errorf("Persist() called %d times, want 1", persister.calls)
+	}
+}
+
+func TestPipelineErrors(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		sats      map[string]satellites.Satellite
+		reporter  Reporter
+		persister *recordingPersister
+		wantCalls int
+	}{
+		{"invalid batch", context.Background(), map[string]satellites.Satellite{"X": nil}, nil, &recordingPersister{}, 0},
+		{"report without compute", context.Background(), testSatellites(), RenderReporter{Renderer: &print.TextRenderer{}}, &recordingPersister{}, 0},
+		{"persist fails", context.Background(), testSatellites(), nil, &recordingPersister{err: errors.New("db down")}, 1},
+		{"cancelled", cancelled, testSatellites(), nil, &recordingPersister{}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Pipeline{
+				Loader:    StaticLoader{"test.csv", tt.sats},
+				Validator: BatchValidator{},
+				Reporter:  tt.reporter,
+				Persister: tt.persister,
+			}
+			if _, err := p.Run(tt.ctx); err == nil {
+				t.Errorf("Run() error = nil, want error")
+			}
+			if tt.persister.calls != tt.wantCalls {
+				t.Errorf("Persist() called %d times, want %d", tt.persister.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestDBPersister(t *testing.T) {
+	store := memory.New()
+	p := &Pipeline{
+		Loader:    StaticLoader{"test.csv", testSatellites()},
+		Computer:  ReportComputer{},
+		Persister: DBPersister{store},
+	}
+	b, err := p.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if b.Run == nil || b.Run.Satellites != 1 || b.Run.Measurements != 1 {
+		t.Errorf("Run() ingest run = %v, want run of 1 satellite and 1 measurement", b.Run)
+	}
+
+	idSat, err := store.GetSatelliteId("8J14")
+	if err != nil || idSat == 0 {
+		t.Fatalf("GetSatelliteId() = %v, %v, want stored satellite", idSat, err)
+	}
+	if computations, _ := store.GetComputations(idSat); len(computations) != 1 || computations[0].AvgRad != 41 {
+		t.Errorf("GetComputations() = %v, want computation with average radiation 41", computations)
+	}
+}
+
+func TestReaderLoader(t *testing.T) {
+	const header = "idSat;timestamp;ionoIndex;ndviIndex;radiationIndex;specificMeasurement\n"
+	tests := []struct {
+		name      string
+		data      string
+		wantStage string
+	}{
+		{"valid", header + "8J14;02-20-2016 15:34;10;49;41;WOODS\n", ""},
+		{"invalid value", header + "8J14;02-20-2016 15:34;WOODS;49;41;WOODS\n", "load"},
+		{"no satellites", header, "validate"},
+		{"ndvi index out of range", header + "8J14;02-20-2016 15:34;10;149;41;WOODS\n", "validate"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Pipeline{
+				Loader:    ReaderLoader{"upload.csv", strings.NewReader(tt.data)},
+				Validator: BatchValidator{},
+				Computer:  ReportComputer{},
+			}
+			b, err := p.Run(context.Background())
+			var stageErr *StageError
+			if errors.As(err, &stageErr) != (tt.wantStage != "") || stageErr != nil && stageErr.Stage != tt.wantStage {
+				t.Fatalf("Run() error = %v, want error in stage %q", err, tt.wantStage)
+			}
+			if err == nil && (b.FileName != "upload.csv" || len(b.Report.Satellites) != 1) {
+				t.Errorf("Run() = %v, want report of 1 satellite from upload.csv", b)
+			}
+		})
+	}
+}
+
+func TestCsvLoaderCancel(t *testing.T) {
+	served := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(served)
+		<-r.Context().Done()
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-served
+		cancel()
+	}()
+	if _, err := (CsvLoader{Url: ts.URL + "/test.csv"}).Load(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Load() error = %v, want %v", err, context.Canceled)
+	}
+}
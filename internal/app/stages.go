@@ -0,0 +1,185 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Simek13/satelliteApp/internal/csv"
+	"github.com/Simek13/satelliteApp/internal/database"
+	"github.com/Simek13/satelliteApp/internal/print"
+	"github.com/Simek13/satelliteApp/internal/report"
+	"github.com/Simek13/satelliteApp/internal/satellites"
+	"github.com/Simek13/satelliteApp/internal/validate"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// CsvLoader loads satellites from a csv file served at Url.
+type CsvLoader struct {
+	Url string
+}
+
+func (l CsvLoader) Load(ctx context.Context) (*Batch, error) {
+	split := strings.Split(l.Url, "/")
+	filename := split[len(split)-1]
+
+	data, err := csv.ReadCsvFromUrl(ctx, l.Url)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error reading csv")
+	}
+	sats, err := csv.ParseCsvData(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error parsing csv data")
+	}
+
+	return &Batch{FileName: filename, Satellites: sats}, nil
+}
+
+// ReaderLoader loads satellites from csv data read from Reader.
+type ReaderLoader struct {
+	FileName string
+	Reader   io.Reader
+}
+
+func (l ReaderLoader) Load(ctx context.Context) (*Batch, error) {
+	data, err := csv.ReadCsv(l.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error reading csv")
+	}
+	sats, err := csv.ParseCsvData(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error parsing csv data")
+	}
+
+	return &Batch{FileName: l.FileName, Satellites: sats}, nil
+}
+
+// StaticLoader hands out satellites that were loaded elsewhere.
+type StaticLoader struct {
+	FileName   string
+	Satellites map[string]satellites.Satellite
+}
+
+func (l StaticLoader) Load(ctx context.Context) (*Batch, error) {
+	return &Batch{FileName: l.FileName, Satellites: l.Satellites}, nil
+}
+
+// BatchValidator checks that every satellite has measurements, that all of
+// its measurement columns have the same length and that the measurements
+// are valid like those added through the API. If Satellites is set,
+// satellites that were deleted there are rejected too.
+type BatchValidator struct {
+	Satellites validate.SatelliteNames
+}
+
+func (v BatchValidator) Validate(ctx context.Context, b *Batch) error {
+	if len(b.Satellites) == 0 {
+		return errors.New("no satellites to process")
+	}
+	for id, sat := range b.Satellites {
+		if sat == nil {
+			return errors.Errorf("satellite %s has unknown type", id)
+		}
+		bSat := sat.GetSatellite()
+		n := len(bSat.Timestamps)
+		if n == 0 {
+			return errors.Errorf("satellite %s has no measurements", id)
+		}
+		lengths := []int{len(bSat.IonoIndexes), len(bSat.NdviIndexes), len(bSat.RadiationIndexes)}
+		switch s := sat.(type) {
+		case *satellites.EaSatellite:
+			lengths = append(lengths, len(s.Altitudes))
+		case *satellites.SsSatellite:
+			lengths = append(lengths, len(s.SeaSalinities))
+		case *satellites.VcSatellite:
+			lengths = append(lengths, len(s.Vegetations))
+		}
+		for _, l := range lengths {
+			if l != n {
+				return errors.Errorf("satellite %s has %d timestamps but %d values in a measurement column", id, n, l)
+			}
+		}
+		for i, m := range database.MeasurementsOf(b.FileName, 0, sat) {
+			if err := validate.Measurement(m, measurementValues...); err != nil {
+				var verr *validate.Error
+				if !errors.As(err, &verr) {
+					return errors.Wrapf(err, "Error validating measurement %d of satellite %s", i, id)
+				}
+				return verr.Prefixed(fmt.Sprintf("%s[%d].", id, i))
+			}
+		}
+		if v.Satellites != nil {
+			if err := validate.SatelliteNotDeleted(v.Satellites, "name", id); err != nil {
+				var verr *validate.Error
+				if !errors.As(err, &verr) {
+					return errors.Wrapf(err, "Error looking up satellite %s", id)
+				}
+				return verr.Prefixed(id + ".")
+			}
+		}
+	}
+	return nil
+}
+
+// measurementValues are the fields of the measurements of a file that are
+// checked. The satellites are only stored by the persister, so their
+// measurements have no satellite id yet.
+var measurementValues = []string{"file_name", "timestamp", "iono_index", "ndvi_index", "radiation_index", "specific"}
+
+// ReportComputer computes the statistics of every satellite and builds the
+// batch report.
+type ReportComputer struct{}
+
+func (ReportComputer) Compute(ctx context.Context, b *Batch) error {
+	b.Report = report.Build(b.FileName, b.Satellites)
+	return nil
+}
+
+// RenderReporter writes the batch report to Writer using Renderer.
+type RenderReporter struct {
+	Renderer print.Renderer
+	Writer   io.Writer
+}
+
+func (r RenderReporter) Report(ctx context.Context, b *Batch) error {
+	if b.Report == nil {
+		return errors.New("no report to render, compute stage was skipped")
+	}
+	return r.Renderer.Render(r.Writer, b.Report)
+}
+
+// DBPersister writes satellites, measurements and computations to Db in a
+// single transaction, so a file is either stored completely or not at all.
+type DBPersister struct {
+	Db database.Store
+}
+
+func (p DBPersister) Persist(ctx context.Context, b *Batch) error {
+	run, err := p.Db.Ingest(b.FileName, b.Satellites)
+	if err != nil {
+		return err
+	}
+	b.Run = run
+	log.WithFields(log.Fields{"event": "persist", "status": "success", "run": run.Id}).Info(
+		fmt.Sprintf("Successfully written %d satellites, %d measurements and %d computations to db.",
+			run.Satellites, run.Measurements, run.Computations))
+	return nil
+}
+
+// DryRunPersister logs what would be written to the database instead of
+// writing it.
+type DryRunPersister struct{}
+
+func (DryRunPersister) Persist(ctx context.Context, b *Batch) error {
+	measurements := 0
+	for _, sat := range b.Satellites {
+		measurements += len(sat.GetSatellite().Timestamps)
+	}
+	log.WithFields(log.Fields{"event": "persist", "status": "skipped"}).Info(
+		fmt.Sprintf("Dry run, would write %d satellites, %d measurements and %d computations to db.",
+			len(b.Satellites), measurements, len(b.Satellites)))
+	return nil
+}
@@ -1,8 +1,11 @@
 package csv
 
 import (
+	"context"
 	"encoding/csv"
+	"io"
 	"net/http"
+	"os"
 	"strconv"
 	"time"
 
@@ -11,14 +14,34 @@ import (
 
 const dateLayout = "01-02-2006 15:04"
 
-func ReadCsvFromUrl(url string) ([][]string, error) {
-	resp, err := http.Get(url)
+// ReadCsvFromUrl downloads and reads the csv file at url. The download is
+// cancelled with ctx.
+func ReadCsvFromUrl(ctx context.Context, url string) ([][]string, error) {
+	rq, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(rq)
 	if err != nil {
 		return nil, err
 	}
 
 	defer resp.Body.Close()
-	reader := csv.NewReader(resp.Body)
+	return ReadCsv(resp.Body)
+}
+
+func ReadCsvFile(path string) ([][]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+	return ReadCsv(f)
+}
+
+func ReadCsv(r io.Reader) ([][]string, error) {
+	reader := csv.NewReader(r)
 	reader.Comma = ';'
 	data, err := reader.ReadAll()
 	if err != nil {
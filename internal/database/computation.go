@@ -2,36 +2,83 @@ package database
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/Simek13/satelliteApp/internal/satellites"
-	pb "github.com/Simek13/satelliteApp/pkg"
+	pb "github.com/Simek13/satelliteApp/pkg/v2"
 	"github.com/doug-martin/goqu/v9"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 const computationTable = "computations"
 
 type Computation struct {
-	Id       int     `db:"id" goqu:"skipinsert, skipupdate"`
-	IdSat    int     `db:"idSat"`
-	Duration string  `db:"duration"`
-	MaxIono  float64 `db:"maxIono"`
-	MinIono  float64 `db:"minIono"`
-	AvgIono  float64 `db:"avgIono"`
-	MaxNdvi  float64 `db:"maxNdvi"`
-	MinNdvi  float64 `db:"minNdvi"`
-	AvgNdvi  float64 `db:"avgNdvi"`
-	MaxRad   float64 `db:"maxRad"`
-	MinRad   float64 `db:"minRad"`
-	AvgRad   float64 `db:"avgRad"`
-	MaxSpec  float64 `db:"maxSpec"`
-	MinSpec  float64 `db:"minSpec"`
-	AvgSpec  float64 `db:"avgSpec"`
+	Id       int           `db:"id" goqu:"skipinsert, skipupdate"`
+	IdSat    int           `db:"idSat"`
+	Duration time.Duration `db:"duration"`
+	MaxIono  float64       `db:"maxIono"`
+	MinIono  float64       `db:"minIono"`
+	AvgIono  float64       `db:"avgIono"`
+	MaxNdvi  float64       `db:"maxNdvi"`
+	MinNdvi  float64       `db:"minNdvi"`
+	AvgNdvi  float64       `db:"avgNdvi"`
+	MaxRad   float64       `db:"maxRad"`
+	MinRad   float64       `db:"minRad"`
+	AvgRad   float64       `db:"avgRad"`
+	MaxSpec  float64       `db:"maxSpec"`
+	MinSpec  float64       `db:"minSpec"`
+	AvgSpec  float64       `db:"avgSpec"`
+	Version  int           `db:"version" goqu:"skipinsert, skipupdate"`
+}
+
+// computationFields maps the fields of pb.Computation that can be updated to
+// their columns.
+var computationFields = map[string]string{
+	"sat_id":   "idSat",
+	"duration": "duration",
+	"max_iono": "maxIono",
+	"min_iono": "minIono",
+	"avg_iono": "avgIono",
+	"max_ndvi": "maxNdvi",
+	"min_ndvi": "minNdvi",
+	"avg_ndvi": "avgNdvi",
+	"max_rad":  "maxRad",
+	"min_rad":  "minRad",
+	"avg_rad":  "avgRad",
+	"max_spec": "maxSpec",
+	"min_spec": "minSpec",
+	"avg_spec": "avgSpec",
+}
+
+// ComputationColumns returns the columns of the pb.Computation fields named by
+// paths, or of every field that can be updated if paths is empty.
+func ComputationColumns(paths []string) ([]string, error) {
+	return columnsOf(computationFields, paths)
+}
+
+func (c *Computation) record() goqu.Record {
+	return goqu.Record{
+		"idSat":    c.IdSat,
+		"duration": c.Duration,
+		"maxIono":  c.MaxIono,
+		"minIono":  c.MinIono,
+		"avgIono":  c.AvgIono,
+		"maxNdvi":  c.MaxNdvi,
+		"minNdvi":  c.MinNdvi,
+		"avgNdvi":  c.AvgNdvi,
+		"maxRad":   c.MaxRad,
+		"minRad":   c.MinRad,
+		"avgRad":   c.AvgRad,
+		"maxSpec":  c.MaxSpec,
+		"minSpec":  c.MinSpec,
+		"avgSpec":  c.AvgSpec,
+	}
 }
 
 func (c Computation) String() string {
-	return fmt.Sprintf("Id: %v, IdSat: %v, Duration: %s, MaxIono: %v, MinIono: %v, AvgIono: %v, MaxNdvi: %v, MinNdvi: %v, AvgNdvi: %v, MaxRad: %v, MinRad: %v, AvgRad: %v, MaxSpec: %v, MinSpec: %v, AvgSpec: %v",
-		c.Id, c.IdSat, c.Duration, c.MaxIono, c.MinIono, c.AvgIono, c.MaxNdvi, c.MinNdvi, c.AvgNdvi, c.MaxRad, c.MinRad, c.AvgRad, c.MaxSpec, c.MinSpec, c.AvgSpec)
+	return fmt.Sprintf("Id: %v, IdSat: %v, Duration: %s, MaxIono: %v, MinIono: %v, AvgIono: %v, MaxNdvi: %v, MinNdvi: %v, AvgNdvi: %v, MaxRad: %v, MinRad: %v, AvgRad: %v, MaxSpec: %v, MinSpec: %v, AvgSpec: %v, Version: %v",
+		c.Id, c.IdSat, c.Duration, c.MaxIono, c.MinIono, c.AvgIono, c.MaxNdvi, c.MinNdvi, c.AvgNdvi, c.MaxRad, c.MinRad, c.AvgRad, c.MaxSpec, c.MinSpec, c.AvgSpec, c.Version)
 }
 
 func (c *Computation) Protobuf() *pb.Computation {
@@ -39,148 +86,235 @@ func (c *Computation) Protobuf() *pb.Computation {
 		return nil
 	}
 
-	computation := &pb.Computation{
+	return &pb.Computation{
 		Id:       int32(c.Id),
-		IdSat:    int32(c.IdSat),
-		Duration: c.Duration,
-		MaxIono:  float32(c.MaxIono),
-		MinIono:  float32(c.MinIono),
-		AvgIono:  float32(c.AvgIono),
-		MaxNdvi:  float32(c.MaxNdvi),
-		MinNdvi:  float32(c.MinNdvi),
-		AvgNdvi:  float32(c.AvgNdvi),
-		MaxRad:   float32(c.MaxRad),
-		MinRad:   float32(c.MinRad),
-		AvgRad:   float32(c.AvgRad),
-		MaxSpec:  float32(c.MaxSpec),
-		MinSpec:  float32(c.MinSpec),
-		AvgSpec:  float32(c.AvgSpec),
-	}
-	return computation
+		SatId:    int32(c.IdSat),
+		Duration: durationpb.New(c.Duration),
+		MaxIono:  c.MaxIono,
+		MinIono:  c.MinIono,
+		AvgIono:  c.AvgIono,
+		MaxNdvi:  c.MaxNdvi,
+		MinNdvi:  c.MinNdvi,
+		AvgNdvi:  c.AvgNdvi,
+		MaxRad:   c.MaxRad,
+		MinRad:   c.MinRad,
+		AvgRad:   c.AvgRad,
+		MaxSpec:  c.MaxSpec,
+		MinSpec:  c.MinSpec,
+		AvgSpec:  c.AvgSpec,
+		Version:  int32(c.Version),
+	}
 }
 
 func NewComputation(c *pb.Computation) *Computation {
 	if c == nil {
 		return nil
 	}
-	computation := &Computation{
+	return &Computation{
 		Id:       int(c.Id),
-		IdSat:    int(c.IdSat),
-		Duration: c.Duration,
-		MaxIono:  float64(c.MaxIono),
-		MinIono:  float64(c.MinIono),
-		AvgIono:  float64(c.AvgIono),
-		MaxNdvi:  float64(c.MaxNdvi),
-		MinNdvi:  float64(c.MinNdvi),
-		AvgNdvi:  float64(c.AvgNdvi),
-		MaxRad:   float64(c.MaxRad),
-		MinRad:   float64(c.MinRad),
-		AvgRad:   float64(c.AvgRad),
-		MaxSpec:  float64(c.MaxSpec),
-		MinSpec:  float64(c.MinSpec),
-		AvgSpec:  float64(c.AvgSpec),
-	}
-	return computation
-}
-
-func (d *MySQLDatabase) AddComputation(c *Computation) error {
+		IdSat:    int(c.SatId),
+		Duration: c.Duration.AsDuration(),
+		MaxIono:  c.MaxIono,
+		MinIono:  c.MinIono,
+		AvgIono:  c.AvgIono,
+		MaxNdvi:  c.MaxNdvi,
+		MinNdvi:  c.MinNdvi,
+		AvgNdvi:  c.AvgNdvi,
+		MaxRad:   c.MaxRad,
+		MinRad:   c.MinRad,
+		AvgRad:   c.AvgRad,
+		MaxSpec:  c.MaxSpec,
+		MinSpec:  c.MinSpec,
+		AvgSpec:  c.AvgSpec,
+		Version:  int(c.Version),
+	}
+}
+
+func (d *SQLDatabase) AddComputation(c *Computation) error {
 	tx, err := d.Begin()
 	if err != nil {
 		return err
 	}
 
 	return tx.Wrap(func() error {
+		return addComputation(tx, c)
+	})
+}
 
-		result, err := tx.Insert(computationTable).
-			Prepared(true).
-			Rows(c).Executor().
-			Exec()
-		if err != nil {
+func addComputation(tx *goqu.TxDatabase, c *Computation) error {
+	id, err := insert(tx, computationTable, c)
+	if err != nil {
+		return err
+	}
+	c.Id = id
+	c.Version = 1
+	return nil
+}
+
+func (d *SQLDatabase) UpdateComputation(c *Computation, columns []string) error {
+	tx, err := d.Begin()
+	if err != nil {
+		return err
+	}
+
+	return tx.Wrap(func() error {
+		if err := updateRow(tx, computationTable, c.Id, c.Version, recordOf(c.record(), columns)); err != nil {
 			return err
 		}
-		id, err := result.LastInsertId()
+		found, err := tx.From(computationTable).Prepared(true).Where(goqu.C("id").Eq(c.Id)).ScanStruct(c)
 		if err != nil {
-			return err
+			return errors.Wrap(err, "Error reading updated computation")
+		}
+		if !found {
+			return NotFound(computationTable, c.Id, errors.Errorf("computation %d does not exist", c.Id))
 		}
-		c.Id = int(id)
 		return nil
 	})
 }
 
-func (d *MySQLDatabase) AddComputations(sats map[string]satellites.Satellite) error {
+func (d *SQLDatabase) DeleteComputation(id, version int) error {
+	tx, err := d.Begin()
+	if err != nil {
+		return err
+	}
+
+	return tx.Wrap(func() error {
+		return deleteRow(tx, computationTable, id, version)
+	})
+}
+
+func (d *SQLDatabase) AddComputations(sats map[string]satellites.Satellite) error {
+	tx, err := d.Begin()
+	if err != nil {
+		return err
+	}
+
+	return tx.Wrap(func() error {
+		_, err := addComputations(tx, sats)
+		return err
+	})
+}
+
+// addComputations inserts the computations of satellites that have none
+// stored yet and returns how many were inserted.
+func addComputations(tx *goqu.TxDatabase, sats map[string]satellites.Satellite) (int, error) {
+	added := 0
 	for name, sat := range sats {
-		idSat, err := d.GetSatelliteId(name)
+		idSat, err := getSatelliteId(tx, name)
 		if err != nil {
-			return err
+			return added, err
 		}
-		bSat := sat.GetSatellite()
-		c := &Computation{
-			IdSat:    idSat,
-			Duration: fmt.Sprint(bSat.Duration),
-			MaxIono:  bSat.IonoCalc[0],
-			MinIono:  bSat.IonoCalc[1],
-			AvgIono:  bSat.IonoCalc[2],
-			MaxNdvi:  bSat.NdviCalc[0],
-			MinNdvi:  bSat.NdviCalc[1],
-			AvgNdvi:  bSat.NdviCalc[2],
-			MaxRad:   bSat.RadiationCalc[0],
-			MinRad:   bSat.RadiationCalc[1],
-			AvgRad:   bSat.RadiationCalc[2],
+		exists, err := hasComputation(tx, idSat)
+		if err != nil {
+			return added, err
 		}
-		switch s := sat.(type) {
-		case *satellites.EaSatellite:
-			c.MaxSpec = s.AltitudesCalc[0]
-			c.MinSpec = s.AltitudesCalc[1]
-			c.AvgSpec = s.AltitudesCalc[2]
-		case *satellites.SsSatellite:
-			c.MaxSpec = s.SalinitiesCalc[0]
-			c.MinSpec = s.SalinitiesCalc[1]
-			c.AvgSpec = s.SalinitiesCalc[2]
-		case *satellites.VcSatellite:
+		if exists {
+			continue
 		}
-		err = d.AddComputation(c)
-
-		err = HandleSqlError(err)
+		err = addComputation(tx, ComputationOf(idSat, sat))
 		if err != nil {
-			return errors.Wrap(err, "Unable to insert computation into database")
+			return added, errors.Wrap(err, "Unable to insert computation into database")
 		}
+		added++
 	}
-	return nil
+	return added, nil
 }
 
-func (d *MySQLDatabase) GetComputations(satId int) ([]Computation, error) {
-	var sql string
-	var err error
-	if satId != 0 {
-		sql, _, err = d.From(computationTable).Where(goqu.C("idSat").Eq(satId)).ToSQL()
-	} else {
-		sql, _, err = d.From(computationTable).ToSQL()
-	}
-
+func hasComputation(db executor, idSat int) (bool, error) {
+	sql, _, err := db.From(computationTable).Select("id").Where(goqu.C("idSat").Eq(idSat)).ToSQL()
 	if err != nil {
-		return nil, errors.Wrap(err, "Error generating sql")
+		return false, errors.Wrap(err, "Error generating sql")
 	}
-	rows, err := d.Query(sql)
+	rows, err := db.Query(sql)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error executing sql query")
+		return false, errors.Wrap(err, "Error executing sql query")
 	}
 	defer rows.Close()
+	exists := rows.Next()
+	if err := rows.Err(); err != nil {
+		return false, errors.Wrap(err, "Error scanning rows")
+	}
+	return exists, nil
+}
+
+// ComputationOf builds the computation row of the stored satellite idSat from
+// a satellite whose statistics have already been computed.
+func ComputationOf(idSat int, sat satellites.Satellite) *Computation {
+	bSat := sat.GetSatellite()
+	c := &Computation{
+		IdSat:    idSat,
+		Duration: bSat.Duration,
+		MinIono:  bSat.IonoCalc[0],
+		MaxIono:  bSat.IonoCalc[1],
+		AvgIono:  bSat.IonoCalc[2],
+		MinNdvi:  bSat.NdviCalc[0],
+		MaxNdvi:  bSat.NdviCalc[1],
+		AvgNdvi:  bSat.NdviCalc[2],
+		MinRad:   bSat.RadiationCalc[0],
+		MaxRad:   bSat.RadiationCalc[1],
+		AvgRad:   bSat.RadiationCalc[2],
+	}
+	switch s := sat.(type) {
+	case *satellites.EaSatellite:
+		c.MinSpec = s.AltitudesCalc[0]
+		c.MaxSpec = s.AltitudesCalc[1]
+		c.AvgSpec = s.AltitudesCalc[2]
+	case *satellites.SsSatellite:
+		c.MinSpec = s.SalinitiesCalc[0]
+		c.MaxSpec = s.SalinitiesCalc[1]
+		c.AvgSpec = s.SalinitiesCalc[2]
+	}
+	return c
+}
+
+func (d *SQLDatabase) GetComputations(satId int) ([]Computation, error) {
+	ds := d.From(computationTable).Order(goqu.C("id").Asc())
+	if satId != 0 {
+		ds = ds.Where(goqu.C("idSat").Eq(satId))
+	}
+
 	computations := make([]Computation, 0)
-	for rows.Next() {
-		var c Computation
-		err := rows.Scan(&c.Id, &c.IdSat, &c.Duration, &c.MaxIono,
-			&c.MinIono, &c.AvgIono, &c.MaxNdvi, &c.MinNdvi,
-			&c.AvgNdvi, &c.MaxRad, &c.MinRad, &c.AvgRad, &c.MaxSpec,
-			&c.MinSpec, &c.AvgSpec)
-		if err != nil {
-			return nil, errors.Wrap(err, "Error scanning rows")
-		}
-		computations = append(computations, c)
+	if err := ds.ScanStructs(&computations); err != nil {
+		return nil, errors.Wrap(err, "Error executing sql query")
 	}
-	err = rows.Err()
+	return computations, nil
+}
+
+func (d *SQLDatabase) ListComputations(satId int, page Page) ([]Computation, string, error) {
+	after, err := page.Cursor()
 	if err != nil {
-		return nil, errors.Wrap(err, "Error scanning rows")
+		return nil, "", err
 	}
 
-	return computations, nil
+	ds := d.From(computationTable).Prepared(true)
+	if satId != 0 {
+		ds = ds.Where(goqu.C("idSat").Eq(satId))
+	}
+	if page.Descending {
+		ds = ds.Order(goqu.C("id").Desc())
+	} else {
+		ds = ds.Order(goqu.C("id").Asc())
+	}
+	if after != nil {
+		if page.Descending {
+			ds = ds.Where(goqu.C("id").Lt(after.Id))
+		} else {
+			ds = ds.Where(goqu.C("id").Gt(after.Id))
+		}
+	}
+	if page.Size > 0 {
+		ds = ds.Limit(uint(page.Size + 1))
+	}
+
+	computations := make([]Computation, 0)
+	if err := ds.ScanStructs(&computations); err != nil {
+		return nil, "", errors.Wrap(err, "Error executing sql query")
+	}
+
+	next := ""
+	if page.Size > 0 && len(computations) > page.Size {
+		computations = computations[:page.Size]
+		next = ComputationCursor(computations[page.Size-1], page.Descending).Token()
+	}
+	return computations, next, nil
 }
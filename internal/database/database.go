@@ -2,48 +2,351 @@ package database
 
 import (
 	"database/sql"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
 
 	"github.com/doug-martin/goqu/v9"
-	"github.com/go-sql-driver/mysql"
 	"github.com/pkg/errors"
+
+	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
+	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+	_ "github.com/doug-martin/goqu/v9/dialect/sqlite3"
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Supported database types. Each one is both the database/sql driver name
+// and the goqu dialect name.
+const (
+	MySQL    = "mysql"
+	Postgres = "postgres"
+	SQLite   = "sqlite3"
 )
 
-const DuplicateEntryNum = 1062
+// Types lists every supported database type.
+var Types = []string{MySQL, Postgres, SQLite}
+
+var identifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
 
-type MySQLDatabase struct {
+// DefaultBatchSize is the number of rows written per multi-row insert when
+// SQLDatabase.BatchSize is not set.
+const DefaultBatchSize = 1000
+
+type SQLDatabase struct {
 	*goqu.Database
+
+	// BatchSize is the number of rows written per multi-row insert by the
+	// bulk insert methods. Zero means DefaultBatchSize.
+	BatchSize int
+
+	db *sql.DB
+}
+
+// executor is implemented by both *goqu.Database and *goqu.TxDatabase, so
+// queries can run either on their own or as part of a transaction.
+type executor interface {
+	From(cols ...interface{}) *goqu.SelectDataset
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// Config describes how to connect to a database. For SQLite, Name is the path
+// of the database file and the other connection fields are ignored.
+type Config struct {
+	Type string
+	User string
+	Pass string
+	Host string
+	Port string
+	Name string
+}
+
+func (c Config) Validate() error {
+	switch c.Type {
+	case MySQL, Postgres:
+	case SQLite:
+		if c.Name == "" {
+			return errors.New("db name is required")
+		}
+		return nil
+	default:
+		return errors.New("invalid db type")
+	}
+	if len(c.User) < 4 || len(c.User) > 100 {
+		return errors.New("db user is not between 4 and 100 characters")
+	}
+	if len(c.Pass) < 4 || len(c.Pass) > 100 {
+		return errors.New("db password is not between 4 and 100 characters")
+	}
+	if len(c.Host) < 4 || len(c.Host) > 100 {
+		return errors.New("db host is not between 4 and 100 characters")
+	}
+	port, err := strconv.Atoi(c.Port)
+	if err != nil {
+		port, err = net.LookupPort("tcp", c.Port)
+		if err != nil {
+			return errors.New("invalid db port")
+		}
+	}
+	if port < 1024 || port > 65535 {
+		return errors.New("db port is not between 1024 and 65535")
+	}
+	if len(c.Name) < 4 || len(c.Name) > 100 {
+		return errors.New("db name is not between 4 and 100 characters")
+	}
+	return nil
+}
+
+// DSN returns the data source name of the database in the syntax of its
+// driver.
+func (c Config) DSN() (string, error) {
+	return c.dsn(c.Name)
+}
+
+// ServerDSN returns the data source name of the database server itself,
+// without selecting the database. It is used to create the database.
+func (c Config) ServerDSN() (string, error) {
+	switch c.Type {
+	case Postgres:
+		return c.dsn("postgres")
+	case SQLite:
+		return c.dsn(c.Name)
+	}
+	return c.dsn("")
 }
 
-func Create(dbBaseUrl, name, dbType string) (*sql.DB, error) {
-	db, err := sql.Open(dbType, dbBaseUrl)
+func (c Config) dsn(name string) (string, error) {
+	switch c.Type {
+	case MySQL:
+		return fmt.Sprintf("%s:%s@tcp(%s)/%s?parseTime=true", c.User, c.Pass, net.JoinHostPort(c.Host, c.Port), name), nil
+	case Postgres:
+		u := url.URL{
+			Scheme:   "postgres",
+			User:     url.UserPassword(c.User, c.Pass),
+			Host:     net.JoinHostPort(c.Host, c.Port),
+			Path:     "/" + name,
+			RawQuery: "sslmode=disable",
+		}
+		return u.String(), nil
+	case SQLite:
+		return fmt.Sprintf("file:%s?_foreign_keys=on", name), nil
+	}
+	return "", errors.Errorf("unsupported db type %q", c.Type)
+}
+
+// Open connects to the database described by c.
+func Open(c Config) (*SQLDatabase, error) {
+	dsn, err := c.DSN()
+	if err != nil {
+		return nil, err
+	}
+	db, err := sql.Open(c.Type, dsn)
 	if err != nil {
 		return nil, err
 	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, ClassifyError(err)
+	}
+	return New(c.Type, db), nil
+}
+
+// New wraps an open connection of the given database type.
+func New(dbType string, db *sql.DB) *SQLDatabase {
+	if dbType == SQLite {
+		// SQLite allows a single writer, concurrent writers fail with
+		// "database is locked" instead of waiting.
+		db.SetMaxOpenConns(1)
+	}
+	return &SQLDatabase{Database: goqu.New(dbType, db), db: db}
+}
+
+func (d *SQLDatabase) Close() error {
+	if d.db == nil {
+		return nil
+	}
+	return d.db.Close()
+}
+
+func (d *SQLDatabase) batchSize() int {
+	if d.BatchSize <= 0 {
+		return DefaultBatchSize
+	}
+	return d.BatchSize
+}
+
+// Create creates the database described by c if it does not exist yet.
+func Create(c Config) error {
+	if c.Type == SQLite {
+		// the database file is created when it is first opened
+		return nil
+	}
+
+	// database names can not be passed as query arguments
+	if !identifier.MatchString(c.Name) {
+		return errors.New("db name may only contain letters, digits and underscores")
+	}
 
+	dsn, err := c.ServerDSN()
+	if err != nil {
+		return err
+	}
+	db, err := sql.Open(c.Type, dsn)
+	if err != nil {
+		return err
+	}
 	defer db.Close()
 
-	_, err = db.Exec("CREATE DATABASE " + name)
+	switch c.Type {
+	case MySQL:
+		_, err = db.Exec("CREATE DATABASE IF NOT EXISTS `" + c.Name + "`")
+		return ClassifyError(err)
+	case Postgres:
+		var exists bool
+		err := db.QueryRow("SELECT EXISTS (SELECT 1 FROM pg_database WHERE datname = $1)", c.Name).Scan(&exists)
+		if err != nil || exists {
+			return ClassifyError(err)
+		}
+		_, err = db.Exec(`CREATE DATABASE "` + c.Name + `"`)
+		return ClassifyError(err)
+	}
+	return errors.Errorf("unsupported db type %q", c.Type)
+}
+
+// insert inserts row into table and returns the generated id. Postgres does
+// not support LastInsertId, so the id is read back with RETURNING there.
+// Errors are classified with ClassifyError.
+func insert(tx *goqu.TxDatabase, table string, row interface{}) (int, error) {
+	ds := tx.Insert(table).Prepared(true).Rows(row)
+	if tx.Dialect() == Postgres {
+		var id int
+		if _, err := ds.Returning("id").Executor().ScanVal(&id); err != nil {
+			return 0, ClassifyError(err)
+		}
+		return id, nil
+	}
+
+	result, err := ds.Executor().Exec()
 	if err != nil {
-		return nil, err
+		return 0, ClassifyError(err)
 	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	return int(id), nil
+}
 
-	_, err = db.Exec("USE " + name)
+// rowVersion returns the version of row id of table.
+func rowVersion(tx *goqu.TxDatabase, table string, id int) (int, error) {
+	var version int
+	found, err := tx.From(table).Prepared(true).Select("version").Where(goqu.C("id").Eq(id)).ScanVal(&version)
 	if err != nil {
-		return nil, err
+		return 0, errors.Wrap(err, "Error reading version")
+	}
+	if !found {
+		return 0, NotFound(table, id, errors.Errorf("row %d of %s does not exist", id, table))
+	}
+	return version, nil
+}
+
+// checkVersion returns the version of row id of table, failing with
+// ErrVersionConflict unless it is version or version is 0.
+func checkVersion(tx *goqu.TxDatabase, table string, id, version int) (int, error) {
+	current, err := rowVersion(tx, table, id)
+	if err != nil {
+		return 0, err
 	}
+	if version != 0 && version != current {
+		return 0, versionConflict(table, id, version)
+	}
+	return current, nil
+}
 
-	return db, nil
+func versionConflict(table string, id, version int) error {
+	return &Error{Kind: ErrVersionConflict, Err: errors.Errorf("row %d of %s is not at version %d", id, table, version), Resource: table, Key: strconv.Itoa(id)}
 }
 
-func HandleSqlError(err error) error {
+// updateRow sets the columns of record on row id of table and increments its
+// version, checking the version like checkVersion.
+func updateRow(tx *goqu.TxDatabase, table string, id, version int, record goqu.Record) error {
+	current, err := checkVersion(tx, table, id, version)
 	if err != nil {
-		if e, ok := err.(*mysql.MySQLError); ok {
-			if e.Number != DuplicateEntryNum {
-				return errors.Wrap(err, "Error inserting into database")
-			}
-		} else {
-			return errors.Wrap(err, "Error inserting into database")
+		return err
+	}
+	record["version"] = goqu.L("? + 1", goqu.C("version"))
+	result, err := tx.Update(table).Prepared(true).Set(record).
+		Where(goqu.C("id").Eq(id), goqu.C("version").Eq(current)).Executor().Exec()
+	if err != nil {
+		return ClassifyError(err)
+	}
+	// the row was updated by someone else since it was read
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return versionConflict(table, id, version)
+	}
+	return nil
+}
+
+// deleteRow deletes row id of table, checking the version like checkVersion.
+func deleteRow(tx *goqu.TxDatabase, table string, id, version int) error {
+	current, err := checkVersion(tx, table, id, version)
+	if err != nil {
+		return err
+	}
+	result, err := tx.Delete(table).Prepared(true).
+		Where(goqu.C("id").Eq(id), goqu.C("version").Eq(current)).Executor().Exec()
+	if err != nil {
+		return ClassifyError(err)
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return versionConflict(table, id, version)
+	}
+	return nil
+}
+
+// columnsOf returns the columns of the fields named by paths, or every column
+// of fields if paths is empty. Fields stored in the same column, like the
+// cases of a oneof, give the column once.
+func columnsOf(fields map[string]string, paths []string) ([]string, error) {
+	if len(paths) == 0 {
+		paths = make([]string, 0, len(fields))
+		for path := range fields {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+	}
+	columns := make([]string, 0, len(paths))
+	seen := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		column, ok := fields[path]
+		if !ok {
+			return nil, errors.Errorf("field %q can not be updated", path)
+		}
+		if !seen[column] {
+			seen[column] = true
+			columns = append(columns, column)
 		}
 	}
+	return columns, nil
+}
+
+// recordOf returns the values of the columns of row.
+func recordOf(row goqu.Record, columns []string) goqu.Record {
+	record := make(goqu.Record, len(columns))
+	for _, column := range columns {
+		record[column] = row[column]
+	}
+	return record
+}
+
+// HandleSqlError ignores duplicate entry errors and wraps any other error.
+func HandleSqlError(err error) error {
+	if err != nil && !errors.Is(ClassifyError(err), ErrDuplicate) {
+		return errors.Wrap(err, "Error inserting into database")
+	}
 	return nil
 }
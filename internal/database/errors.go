@@ -0,0 +1,163 @@
+package database
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"net"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
+	"github.com/pkg/errors"
+)
+
+// Error kinds returned by ClassifyError. Test for them with errors.Is.
+var (
+	ErrDuplicate  = errors.New("duplicate entry")
+	ErrForeignKey = errors.New("foreign key violation")
+	ErrNotFound   = errors.New("not found")
+	// ErrInvalid is returned for values the database can not store, like
+	// missing required values or values out of range.
+	ErrInvalid = errors.New("invalid value")
+	// ErrUnavailable is returned when the database can not be reached.
+	ErrUnavailable = errors.New("database unavailable")
+	// ErrVersionConflict is returned when a row was changed since the
+	// version the caller expects.
+	ErrVersionConflict = errors.New("version conflict")
+)
+
+const (
+	DuplicateEntryNum    = 1062
+	mysqlRowIsReferenced = 1451
+	mysqlNoReferencedRow = 1452
+	mysqlTooManyConns    = 1040
+	mysqlShutdown        = 1053
+	mysqlBadNull         = 1048
+	mysqlOutOfRange      = 1264
+	mysqlTruncated       = 1292
+	mysqlIncorrectValue  = 1366
+	mysqlDataTooLong     = 1406
+
+	pgUniqueViolation      = "23505"
+	pgForeignKeyViolation  = "23503"
+	pgNotNullViolation     = "23502"
+	pgCheckViolation       = "23514"
+	pgDataException        = "22"
+	pgConnectionException  = "08"
+	pgOperatorIntervention = "57"
+)
+
+// Error is a database error of a known kind. Resource and Key name the row
+// the error is about, if it is known, like "satellites" and "3".
+type Error struct {
+	Kind     error
+	Err      error
+	Resource string
+	Key      string
+}
+
+// NotFound returns an ErrNotFound error about the row of resource with the
+// key.
+func NotFound(resource string, key interface{}, err error) *Error {
+	return &Error{Kind: ErrNotFound, Err: err, Resource: resource, Key: fmt.Sprint(key)}
+}
+
+// Deleted returns an ErrInvalid error about the soft deleted row of resource
+// with the key, which can not be written to.
+func Deleted(resource string, key interface{}, err error) *Error {
+	return &Error{Kind: ErrInvalid, Err: err, Resource: resource, Key: fmt.Sprint(key)}
+}
+
+func (e *Error) Error() string {
+	return e.Kind.Error() + ": " + e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func (e *Error) Is(target error) bool {
+	return target == e.Kind
+}
+
+// ClassifyError wraps driver errors of every supported database into an
+// *Error of the matching kind. Errors of unknown kinds are returned unchanged.
+func ClassifyError(err error) error {
+	var classified *Error
+	if err == nil || errors.As(err, &classified) {
+		return err
+	}
+	if kind := errorKind(err); kind != nil {
+		return &Error{Kind: kind, Err: err}
+	}
+	return err
+}
+
+func errorKind(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	}
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, sql.ErrConnDone) || errors.Is(err, mysql.ErrInvalidConn) {
+		return ErrUnavailable
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return ErrUnavailable
+	}
+
+	var myErr *mysql.MySQLError
+	if errors.As(err, &myErr) {
+		switch myErr.Number {
+		case DuplicateEntryNum:
+			return ErrDuplicate
+		case mysqlRowIsReferenced, mysqlNoReferencedRow:
+			return ErrForeignKey
+		case mysqlBadNull, mysqlOutOfRange, mysqlTruncated, mysqlIncorrectValue, mysqlDataTooLong:
+			return ErrInvalid
+		case mysqlTooManyConns, mysqlShutdown:
+			return ErrUnavailable
+		}
+		return nil
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case pgUniqueViolation:
+			return ErrDuplicate
+		case pgForeignKeyViolation:
+			return ErrForeignKey
+		case pgNotNullViolation, pgCheckViolation:
+			return ErrInvalid
+		}
+		switch pqErr.Code.Class() {
+		case pgDataException:
+			return ErrInvalid
+		case pgConnectionException, pgOperatorIntervention:
+			return ErrUnavailable
+		}
+		return nil
+	}
+
+	var liteErr sqlite3.Error
+	if errors.As(err, &liteErr) {
+		switch liteErr.ExtendedCode {
+		case sqlite3.ErrConstraintUnique, sqlite3.ErrConstraintPrimaryKey:
+			return ErrDuplicate
+		case sqlite3.ErrConstraintForeignKey:
+			return ErrForeignKey
+		case sqlite3.ErrConstraintNotNull, sqlite3.ErrConstraintCheck:
+			return ErrInvalid
+		}
+		switch liteErr.Code {
+		case sqlite3.ErrTooBig, sqlite3.ErrMismatch, sqlite3.ErrRange:
+			return ErrInvalid
+		case sqlite3.ErrBusy, sqlite3.ErrLocked, sqlite3.ErrCantOpen:
+			return ErrUnavailable
+		}
+		return nil
+	}
+
+	return nil
+}
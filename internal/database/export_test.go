@@ -0,0 +1,4 @@
+package database
+
+// NewTestDatabase exports newTestDatabase to the external tests.
+var NewTestDatabase = newTestDatabase
@@ -0,0 +1,81 @@
+package database
+
+import (
+	"time"
+
+	"github.com/Simek13/satelliteApp/internal/satellites"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/pkg/errors"
+)
+
+const ingestRunTable = "ingest_runs"
+
+// IngestRun records one file that was fully written to the database.
+type IngestRun struct {
+	Id           int       `db:"id" goqu:"skipinsert, skipupdate"`
+	FileName     string    `db:"filename"`
+	Satellites   int       `db:"satellites"`
+	Measurements int       `db:"measurements"`
+	Computations int       `db:"computations"`
+	Created      time.Time `db:"created"`
+}
+
+// Ingest writes the satellites, measurements and computations of one file in
+// a single transaction, together with an ingest run row marking it complete.
+// On any error the transaction is rolled back and nothing of the file is kept.
+func (d *SQLDatabase) Ingest(filename string, sats map[string]satellites.Satellite) (*IngestRun, error) {
+	tx, err := d.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	run := &IngestRun{FileName: filename}
+	err = tx.Wrap(func() error {
+		var err error
+		if run.Satellites, err = addSatellites(tx, sats); err != nil {
+			return err
+		}
+		if run.Measurements, err = addMeasurements(tx, filename, sats, d.batchSize()); err != nil {
+			return err
+		}
+		if run.Computations, err = addComputations(tx, sats); err != nil {
+			return err
+		}
+		return addIngestRun(tx, run)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to ingest file, no data was written")
+	}
+	return run, nil
+}
+
+// IngestMeasurements writes measurements in batches of BatchSize rows in a
+// single transaction, together with an ingest run row marking them complete.
+func (d *SQLDatabase) IngestMeasurements(filename string, ms []*Measurement) (*IngestRun, error) {
+	tx, err := d.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	run := &IngestRun{FileName: filename, Measurements: len(ms)}
+	err = tx.Wrap(func() error {
+		if err := bulkAddMeasurements(tx, ms, d.batchSize()); err != nil {
+			return errors.Wrap(err, "Unable to insert measurements into database")
+		}
+		return addIngestRun(tx, run)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to ingest measurements, no data was written")
+	}
+	return run, nil
+}
+
+func addIngestRun(tx *goqu.TxDatabase, run *IngestRun) error {
+	run.Created = time.Now().UTC()
+	id, err := insert(tx, ingestRunTable, run)
+	if err != nil {
+		return errors.Wrap(err, "Unable to insert ingest run into database")
+	}
+	run.Id = id
+	return nil
+}
@@ -2,41 +2,94 @@ package database
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/Simek13/satelliteApp/internal/satellites"
-	pb "github.com/Simek13/satelliteApp/pkg"
+	pb "github.com/Simek13/satelliteApp/pkg/v2"
 	"github.com/doug-martin/goqu/v9"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const measurementTable = "measurements"
 
 type Measurement struct {
-	Id                  int     `db:"id" goqu:"skipinsert, skipupdate"`
-	FileName            string  `db:"filename"`
-	IdSat               int     `db:"idSat"`
-	Timestamp           string  `db:"timestamp"`
-	IonoIndex           float64 `db:"ionoIndex"`
-	NdviIndex           float64 `db:"ndviIndex"`
-	RadiationIndex      float64 `db:"radiationIndex"`
-	SpecificMeasurement string  `db:"specificMeasurement"`
+	Id                  int       `db:"id" goqu:"skipinsert, skipupdate"`
+	FileName            string    `db:"filename"`
+	IdSat               int       `db:"idSat"`
+	Timestamp           time.Time `db:"timestamp"`
+	IonoIndex           float64   `db:"ionoIndex"`
+	NdviIndex           float64   `db:"ndviIndex"`
+	RadiationIndex      float64   `db:"radiationIndex"`
+	SpecificMeasurement string    `db:"specificMeasurement"`
+	Version             int       `db:"version" goqu:"skipinsert, skipupdate"`
 }
 
-func (m *Measurement) Protobuf() *pb.Measurement {
+// measurementFields maps the fields of pb.Measurement that can be updated to
+// their columns.
+var measurementFields = map[string]string{
+	"file_name":       "filename",
+	"sat_id":          "idSat",
+	"timestamp":       "timestamp",
+	"iono_index":      "ionoIndex",
+	"ndvi_index":      "ndviIndex",
+	"radiation_index": "radiationIndex",
+	"altitude":        "specificMeasurement",
+	"sea_salinity":    "specificMeasurement",
+	"vegetation":      "specificMeasurement",
+	"other":           "specificMeasurement",
+}
+
+// MeasurementColumns returns the columns of the pb.Measurement fields named by
+// paths, or of every field that can be updated if paths is empty.
+func MeasurementColumns(paths []string) ([]string, error) {
+	return columnsOf(measurementFields, paths)
+}
+
+func (m *Measurement) record() goqu.Record {
+	return goqu.Record{
+		"filename":            m.FileName,
+		"idSat":               m.IdSat,
+		"timestamp":           m.Timestamp.UTC(),
+		"ionoIndex":           m.IonoIndex,
+		"ndviIndex":           m.NdviIndex,
+		"radiationIndex":      m.RadiationIndex,
+		"specificMeasurement": m.SpecificMeasurement,
+	}
+}
+
+// Protobuf converts m to a measurement of the API. The specific measurement is
+// typed by satType, the Type of the satellite of m. It is other if the type is
+// not known or the measurement does not match it.
+func (m *Measurement) Protobuf(satType string) *pb.Measurement {
 	if m == nil {
 		return nil
 	}
 
 	measurement := &pb.Measurement{
-		Id:                  int32(m.Id),
-		FileName:            m.FileName,
-		IdSat:               int32(m.IdSat),
-		Timestamp:           m.Timestamp,
-		IonoIndex:           float32(m.IonoIndex),
-		NdviIndex:           float32(m.NdviIndex),
-		RadiationIndex:      float32(m.RadiationIndex),
-		SpecificMeasurement: m.SpecificMeasurement,
+		Id:             int32(m.Id),
+		FileName:       m.FileName,
+		SatId:          int32(m.IdSat),
+		Timestamp:      timestamppb.New(m.Timestamp),
+		IonoIndex:      m.IonoIndex,
+		NdviIndex:      m.NdviIndex,
+		RadiationIndex: m.RadiationIndex,
+		Version:        int32(m.Version),
+	}
+	if m.SpecificMeasurement == "" {
+		return measurement
+	}
+	value, err := strconv.ParseFloat(m.SpecificMeasurement, 64)
+	switch {
+	case satType == satellites.Ea.String() && err == nil:
+		measurement.Specific = &pb.Measurement_Altitude{Altitude: value}
+	case satType == satellites.Ss.String() && err == nil:
+		measurement.Specific = &pb.Measurement_SeaSalinity{SeaSalinity: value}
+	case satType == satellites.Vc.String():
+		measurement.Specific = &pb.Measurement_Vegetation{Vegetation: m.SpecificMeasurement}
+	default:
+		measurement.Specific = &pb.Measurement_Other{Other: m.SpecificMeasurement}
 	}
 	return measurement
 }
@@ -46,123 +99,319 @@ func NewMeasurement(m *pb.Measurement) *Measurement {
 		return nil
 	}
 	measurement := &Measurement{
-		Id:                  int(m.Id),
-		FileName:            m.FileName,
-		IdSat:               int(m.IdSat),
-		Timestamp:           m.Timestamp,
-		IonoIndex:           float64(m.IonoIndex),
-		NdviIndex:           float64(m.NdviIndex),
-		RadiationIndex:      float64(m.RadiationIndex),
-		SpecificMeasurement: m.SpecificMeasurement,
+		Id:             int(m.Id),
+		FileName:       m.FileName,
+		IdSat:          int(m.SatId),
+		Timestamp:      m.Timestamp.AsTime(),
+		IonoIndex:      m.IonoIndex,
+		NdviIndex:      m.NdviIndex,
+		RadiationIndex: m.RadiationIndex,
+		Version:        int(m.Version),
+	}
+	switch specific := m.Specific.(type) {
+	case *pb.Measurement_Altitude:
+		measurement.SpecificMeasurement = strconv.FormatFloat(specific.Altitude, 'f', -1, 64)
+	case *pb.Measurement_SeaSalinity:
+		measurement.SpecificMeasurement = strconv.FormatFloat(specific.SeaSalinity, 'f', -1, 64)
+	case *pb.Measurement_Vegetation:
+		measurement.SpecificMeasurement = specific.Vegetation
+	case *pb.Measurement_Other:
+		measurement.SpecificMeasurement = specific.Other
 	}
 	return measurement
 }
 
 func (m Measurement) String() string {
-	return fmt.Sprintf("Id: %v, Filename: %s, IdSat: %v, Timestamp: %s, IonoIndex: %v, NdviIndex: %v, RadiationIndex: %v, SpecificMeasurement: %s",
-		m.Id, m.FileName, m.IdSat, m.Timestamp, m.IonoIndex, m.NdviIndex, m.RadiationIndex, m.SpecificMeasurement)
+	return fmt.Sprintf("Id: %v, Filename: %s, IdSat: %v, Timestamp: %v, IonoIndex: %v, NdviIndex: %v, RadiationIndex: %v, SpecificMeasurement: %s, Version: %v",
+		m.Id, m.FileName, m.IdSat, m.Timestamp, m.IonoIndex, m.NdviIndex, m.RadiationIndex, m.SpecificMeasurement, m.Version)
 }
 
-func (d *MySQLDatabase) AddMeasurement(m *Measurement) error {
+func (d *SQLDatabase) AddMeasurement(m *Measurement) error {
 	tx, err := d.Begin()
 	if err != nil {
 		return err
 	}
-	fmt.Println(m)
 
 	return tx.Wrap(func() error {
+		return addMeasurement(tx, m)
+	})
+}
 
-		result, err := tx.Insert(measurementTable).
-			Prepared(true).
-			Rows(m).Executor().
-			Exec()
-		if err != nil {
+func addMeasurement(tx *goqu.TxDatabase, m *Measurement) error {
+	id, err := insert(tx, measurementTable, m)
+	if err != nil {
+		return err
+	}
+	m.Id = id
+	m.Version = 1
+	return nil
+}
+
+func (d *SQLDatabase) UpdateMeasurement(m *Measurement, columns []string) error {
+	tx, err := d.Begin()
+	if err != nil {
+		return err
+	}
+
+	return tx.Wrap(func() error {
+		if err := updateRow(tx, measurementTable, m.Id, m.Version, recordOf(m.record(), columns)); err != nil {
 			return err
 		}
-		id, err := result.LastInsertId()
+		found, err := tx.From(measurementTable).Prepared(true).Where(goqu.C("id").Eq(m.Id)).ScanStruct(m)
 		if err != nil {
-			return err
+			return errors.Wrap(err, "Error reading updated measurement")
 		}
-		m.Id = int(id)
+		if !found {
+			return NotFound(measurementTable, m.Id, errors.Errorf("measurement %d does not exist", m.Id))
+		}
+		m.Timestamp = m.Timestamp.UTC()
 		return nil
 	})
 }
 
-func (d *MySQLDatabase) AddMeasurements(filename string, sats map[string]satellites.Satellite) error {
+func (d *SQLDatabase) DeleteMeasurement(id, version int) error {
+	tx, err := d.Begin()
+	if err != nil {
+		return err
+	}
+
+	return tx.Wrap(func() error {
+		return deleteRow(tx, measurementTable, id, version)
+	})
+}
+
+// BulkAddMeasurements inserts measurements in a single transaction using
+// multi-row inserts of BatchSize rows. Ids of the inserted measurements are
+// not set.
+func (d *SQLDatabase) BulkAddMeasurements(ms []*Measurement) error {
+	tx, err := d.Begin()
+	if err != nil {
+		return err
+	}
+
+	return tx.Wrap(func() error {
+		return bulkAddMeasurements(tx, ms, d.batchSize())
+	})
+}
+
+func bulkAddMeasurements(tx *goqu.TxDatabase, ms []*Measurement, batchSize int) error {
+	for from := 0; from < len(ms); from += batchSize {
+		to := from + batchSize
+		if to > len(ms) {
+			to = len(ms)
+		}
+		_, err := tx.Insert(measurementTable).
+			Prepared(true).
+			Rows(ms[from:to]).Executor().
+			Exec()
+		if err != nil {
+			return ClassifyError(err)
+		}
+	}
+	return nil
+}
+
+func (d *SQLDatabase) AddMeasurements(filename string, sats map[string]satellites.Satellite) error {
+	tx, err := d.Begin()
+	if err != nil {
+		return err
+	}
+
+	return tx.Wrap(func() error {
+		_, err := addMeasurements(tx, filename, sats, d.batchSize())
+		return err
+	})
+}
+
+// addMeasurements inserts every measurement of the satellites in batches and
+// returns how many were inserted. The satellites must already be stored.
+func addMeasurements(tx *goqu.TxDatabase, filename string, sats map[string]satellites.Satellite, batchSize int) (int, error) {
+	added := 0
 	for name, sat := range sats {
+		idSat, err := getSatelliteId(tx, name)
+		if err != nil {
+			return added, err
+		}
+		ms := MeasurementsOf(filename, idSat, sat)
+		err = bulkAddMeasurements(tx, ms, batchSize)
+		if err != nil {
+			return added, errors.Wrap(err, "Unable to insert measurements into database")
+		}
+		added += len(ms)
+	}
+	return added, nil
+}
+
+// MeasurementsOf converts the measurements of a satellite into rows of the
+// stored satellite idSat.
+func MeasurementsOf(filename string, idSat int, sat satellites.Satellite) []*Measurement {
+	bSat := sat.GetSatellite()
+	measurements := make([]*Measurement, 0, len(bSat.Timestamps))
+	for i := range bSat.Timestamps {
+		m := &Measurement{
+			FileName:       filename,
+			IdSat:          idSat,
+			Timestamp:      bSat.Timestamps[i].UTC(),
+			IonoIndex:      bSat.IonoIndexes[i],
+			NdviIndex:      bSat.NdviIndexes[i],
+			RadiationIndex: bSat.RadiationIndexes[i],
+		}
+		switch s := sat.(type) {
+		case *satellites.EaSatellite:
+			m.SpecificMeasurement = strconv.FormatFloat(s.Altitudes[i], 'f', -1, 64)
+		case *satellites.SsSatellite:
+			m.SpecificMeasurement = strconv.FormatFloat(s.SeaSalinities[i], 'f', -1, 64)
+		case *satellites.VcSatellite:
+			m.SpecificMeasurement = s.Vegetations[i]
+		}
+		measurements = append(measurements, m)
+	}
+	return measurements
+}
 
-		bSat := sat.GetSatellite()
-		idSat, err := d.GetSatelliteId(name)
+// SatelliteOf converts stored measurements of sat back into a satellite of
+// its type, so its statistics can be computed like those of a read file.
+// Measurements of satellites of unknown type keep no specific measurements.
+func SatelliteOf(sat *Satellite, ms []Measurement) (satellites.Satellite, error) {
+	bSat := satellites.BasicSatellite{Id: sat.Name}
+	for _, m := range ms {
+		bSat.Timestamps = append(bSat.Timestamps, m.Timestamp)
+		bSat.IonoIndexes = append(bSat.IonoIndexes, m.IonoIndex)
+		bSat.NdviIndexes = append(bSat.NdviIndexes, m.NdviIndex)
+		bSat.RadiationIndexes = append(bSat.RadiationIndexes, m.RadiationIndex)
+	}
+
+	switch sat.Type {
+	case satellites.Ea.String():
+		bSat.SatelliteType = satellites.Ea
+		altitudes, err := parseFloats(ms)
 		if err != nil {
-			return err
+			return nil, errors.Wrap(err, "Invalid altitude")
+		}
+		return &satellites.EaSatellite{BasicSatellite: bSat, Altitudes: altitudes}, nil
+	case satellites.Ss.String():
+		bSat.SatelliteType = satellites.Ss
+		salinities, err := parseFloats(ms)
+		if err != nil {
+			return nil, errors.Wrap(err, "Invalid sea salinity")
 		}
-		var timestamp time.Time
-		var ionoIndex, ndviIndex, radiationIndex float64
-		for i := range sat.GetSatellite().Timestamps {
-			timestamp = bSat.Timestamps[i]
-			ionoIndex = bSat.IonoIndexes[i]
-			ndviIndex = bSat.NdviIndexes[i]
-			radiationIndex = bSat.RadiationIndexes[i]
-
-			m := &Measurement{
-				FileName:       filename,
-				IdSat:          idSat,
-				Timestamp:      timestamp.String(),
-				IonoIndex:      ionoIndex,
-				NdviIndex:      ndviIndex,
-				RadiationIndex: radiationIndex,
-			}
-			switch s := sat.(type) {
-			case *satellites.EaSatellite:
-				m.SpecificMeasurement = fmt.Sprintf("%f", s.Altitudes[i])
-			case *satellites.SsSatellite:
-				m.SpecificMeasurement = fmt.Sprintf("%f", s.SeaSalinities[i])
-			case *satellites.VcSatellite:
-				m.SpecificMeasurement = s.Vegetations[i]
-			}
-
-			err = d.AddMeasurement(m)
-
-			err = HandleSqlError(err)
-			if err != nil {
-				return errors.Wrap(err, "Unable to insert measurement into database")
-			}
+		return &satellites.SsSatellite{BasicSatellite: bSat, SeaSalinities: salinities}, nil
+	case satellites.Vc.String():
+		bSat.SatelliteType = satellites.Vc
+		vegetations := make([]string, 0, len(ms))
+		for _, m := range ms {
+			vegetations = append(vegetations, m.SpecificMeasurement)
 		}
+		return &satellites.VcSatellite{BasicSatellite: bSat, Vegetations: vegetations}, nil
+	}
+	return &bSat, nil
+}
 
+// parseFloats parses the specific measurements of ms.
+func parseFloats(ms []Measurement) ([]float64, error) {
+	values := make([]float64, 0, len(ms))
+	for _, m := range ms {
+		v, err := strconv.ParseFloat(m.SpecificMeasurement, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "measurement %d", m.Id)
+		}
+		values = append(values, v)
 	}
-	return nil
+	return values, nil
 }
 
-func (d *MySQLDatabase) GetMeasurements(satId int) ([]Measurement, error) {
-	var sql string
-	var err error
+func (d *SQLDatabase) GetMeasurements(satId int) ([]Measurement, error) {
+	ds := d.From(measurementTable).Order(goqu.C("id").Asc())
 	if satId != 0 {
-		sql, _, err = d.From(measurementTable).Where(goqu.C("idSat").Eq(satId)).ToSQL()
-	} else {
-		sql, _, err = d.From(measurementTable).ToSQL()
+		ds = ds.Where(goqu.C("idSat").Eq(satId))
 	}
 
-	if err != nil {
-		return nil, errors.Wrap(err, "Error generating sql")
+	measurements := make([]Measurement, 0)
+	if err := ds.ScanStructs(&measurements); err != nil {
+		return nil, errors.Wrap(err, "Error executing sql query")
+	}
+	for i := range measurements {
+		measurements[i].Timestamp = measurements[i].Timestamp.UTC()
 	}
-	rows, err := d.Query(sql)
+	return measurements, nil
+}
+
+func (d *SQLDatabase) GetMeasurementsBetween(satId int, start, end time.Time) ([]Measurement, error) {
+	measurements, _, err := d.ListMeasurements(MeasurementQuery{SatId: satId, Start: start, End: end}, Page{})
+	return measurements, err
+}
+
+func (d *SQLDatabase) ListMeasurements(q MeasurementQuery, page Page) ([]Measurement, string, error) {
+	after, err := page.Cursor()
 	if err != nil {
-		return nil, errors.Wrap(err, "Error executing sql query")
+		return nil, "", err
 	}
-	defer rows.Close()
-	measurements := make([]Measurement, 0)
-	for rows.Next() {
-		var m Measurement
-		err := rows.Scan(&m.Id, &m.FileName, &m.IdSat, &m.Timestamp, &m.IonoIndex, &m.NdviIndex, &m.RadiationIndex, &m.SpecificMeasurement)
-		if err != nil {
-			return nil, errors.Wrap(err, "Error scanning rows")
+
+	ds := d.From(measurementTable).Prepared(true)
+	if q.SatId != 0 {
+		ds = ds.Where(goqu.C("idSat").Eq(q.SatId))
+	}
+	if !q.Start.IsZero() {
+		ds = ds.Where(goqu.C("timestamp").Gte(q.Start.UTC()))
+	}
+	if !q.End.IsZero() {
+		ds = ds.Where(goqu.C("timestamp").Lt(q.End.UTC()))
+	}
+	if page.Descending {
+		ds = ds.Order(goqu.C("timestamp").Desc(), goqu.C("id").Desc())
+	} else {
+		ds = ds.Order(goqu.C("timestamp").Asc(), goqu.C("id").Asc())
+	}
+	if after != nil {
+		ts, id := goqu.C("timestamp"), goqu.C("id")
+		if page.Descending {
+			ds = ds.Where(goqu.Or(ts.Lt(after.Timestamp), goqu.And(ts.Eq(after.Timestamp), id.Lt(after.Id))))
+		} else {
+			ds = ds.Where(goqu.Or(ts.Gt(after.Timestamp), goqu.And(ts.Eq(after.Timestamp), id.Gt(after.Id))))
 		}
-		measurements = append(measurements, m)
 	}
-	err = rows.Err()
-	if err != nil {
-		return nil, errors.Wrap(err, "Error scanning rows")
+	if page.Size > 0 {
+		// one more row tells whether there is a next page
+		ds = ds.Limit(uint(page.Size + 1))
 	}
 
+	measurements := make([]Measurement, 0)
+	if err := ds.ScanStructs(&measurements); err != nil {
+		return nil, "", errors.Wrap(err, "Error executing sql query")
+	}
+	for i := range measurements {
+		measurements[i].Timestamp = measurements[i].Timestamp.UTC()
+	}
+
+	next := ""
+	if page.Size > 0 && len(measurements) > page.Size {
+		measurements = measurements[:page.Size]
+		next = MeasurementCursor(measurements[page.Size-1], page.Descending).Token()
+	}
+	return measurements, next, nil
+}
+
+func (d *SQLDatabase) MeasurementsAfter(id, limit int) ([]Measurement, error) {
+	ds := d.From(measurementTable).Prepared(true).
+		Where(goqu.C("id").Gt(id)).
+		Order(goqu.C("id").Asc()).
+		Limit(uint(limit))
+
+	measurements := make([]Measurement, 0)
+	if err := ds.ScanStructs(&measurements); err != nil {
+		return nil, errors.Wrap(err, "Error executing sql query")
+	}
+	for i := range measurements {
+		measurements[i].Timestamp = measurements[i].Timestamp.UTC()
+	}
 	return measurements, nil
 }
+
+func (d *SQLDatabase) LastMeasurementId() (int, error) {
+	var id int
+	_, err := d.From(measurementTable).Select(goqu.COALESCE(goqu.MAX("id"), 0)).ScanVal(&id)
+	if err != nil {
+		return 0, errors.Wrap(err, "Error executing sql query")
+	}
+	return id, nil
+}
@@ -0,0 +1,151 @@
+package database
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Simek13/satelliteApp/internal/satellites"
+	pb "github.com/Simek13/satelliteApp/pkg/v2"
+)
+
+// benchRows is the number of measurements written per benchmark iteration.
+const benchRows = 1000
+
+func benchMeasurements(b *testing.B, d *SQLDatabase) []*Measurement {
+	s := &Satellite{Name: "BENCH1"}
+	idSat, err := d.GetSatelliteId(s.Name)
+	if err != nil {
+		b.Fatalf("GetSatelliteId() error = %v", err)
+	}
+	if idSat == 0 {
+		if err := d.AddSatellite(s); err != nil {
+			b.Fatalf("AddSatellite() error = %v", err)
+		}
+		idSat = s.Id
+	}
+
+	ms := make([]*Measurement, 0, benchRows)
+	start := time.Date(2016, 02, 20, 15, 0, 0, 0, time.UTC)
+	for i := 0; i < benchRows; i++ {
+		ms = append(ms, &Measurement{
+			FileName:            "bench.csv",
+			IdSat:               idSat,
+			Timestamp:           start.Add(time.Duration(i) * time.Minute),
+			IonoIndex:           float64(i % 30),
+			NdviIndex:           float64(i % 60),
+			RadiationIndex:      float64(i%50) + 0.5,
+			SpecificMeasurement: strconv.FormatFloat(830.9+float64(i%10), 'f', -1, 64),
+		})
+	}
+	return ms
+}
+
+func BenchmarkAddMeasurement(b *testing.B) {
+	d := newTestDatabase(b)
+	ms := benchMeasurements(b, d)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for _, m := range ms {
+			if err := d.AddMeasurement(m); err != nil {
+				b.Fatalf("AddMeasurement() error = %v", err)
+			}
+		}
+	}
+}
+
+func BenchmarkBulkAddMeasurements(b *testing.B) {
+	for _, size := range []int{1, 100, 1000} {
+		b.Run(fmt.Sprintf("batch=%d", size), func(b *testing.B) {
+			d := newTestDatabase(b)
+			d.BatchSize = size
+			ms := benchMeasurements(b, d)
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				if err := d.BulkAddMeasurements(ms); err != nil {
+					b.Fatalf("BulkAddMeasurements() error = %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestSatelliteOf(t *testing.T) {
+	start := time.Date(2016, 02, 20, 15, 0, 0, 0, time.UTC)
+	basic := satellites.BasicSatellite{
+		Id:               "30J14",
+		Timestamps:       []time.Time{start, start.Add(2 * time.Minute), start.Add(time.Minute)},
+		IonoIndexes:      []float64{5, 7, 9},
+		NdviIndexes:      []float64{29, 33, 30},
+		RadiationIndexes: []float64{32, 32.5, 31},
+	}
+	ea, ss, vc := basic, basic, basic
+	ea.SatelliteType, ss.SatelliteType, vc.SatelliteType = satellites.Ea, satellites.Ss, satellites.Vc
+
+	tests := []satellites.Satellite{
+		&satellites.EaSatellite{BasicSatellite: ea, Altitudes: []float64{830.5, 833.5, 831.123456789}},
+		&satellites.SsSatellite{BasicSatellite: ss, SeaSalinities: []float64{35.1, 34.9, 35.000000012}},
+		&satellites.VcSatellite{BasicSatellite: vc, Vegetations: []string{"WOODS", "GRASS", "WOODS"}},
+	}
+	for _, sat := range tests {
+		t.Run(sat.GetSatellite().SatelliteType.String(), func(t *testing.T) {
+			sat.MeasurementTime()
+			sat.Compute()
+			want := ComputationOf(1, sat)
+
+			ms := make([]Measurement, 0)
+			for _, m := range MeasurementsOf("a.csv", 1, sat) {
+				ms = append(ms, *m)
+			}
+			got, err := SatelliteOf(&Satellite{Id: 1, Name: "30J14", Type: sat.GetSatellite().SatelliteType.String()}, ms)
+			if err != nil {
+				t.Fatalf("SatelliteOf() error = %v", err)
+			}
+			got.MeasurementTime()
+			got.Compute()
+			if c := ComputationOf(1, got); !reflect.DeepEqual(c, want) {
+				t.Errorf("computation of SatelliteOf() = %v, want %v", c, want)
+			}
+		})
+	}
+
+	ms := []Measurement{{Id: 7, SpecificMeasurement: "WOODS"}}
+	if _, err := SatelliteOf(&Satellite{Type: satellites.Ea.String()}, ms); err == nil {
+		t.Errorf("SatelliteOf() with invalid altitude error = nil")
+	}
+}
+
+func TestMeasurementProtobuf(t *testing.T) {
+	ts := time.Date(2016, 02, 20, 15, 19, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		satType  string
+		specific string
+		want     interface{}
+	}{
+		{"altitude", "Ea", "830.123456789", &pb.Measurement_Altitude{Altitude: 830.123456789}},
+		{"salinity", "Ss", "2.2", &pb.Measurement_SeaSalinity{SeaSalinity: 2.2}},
+		{"vegetation", "Vc", "WOODS", &pb.Measurement_Vegetation{Vegetation: "WOODS"}},
+		{"unknown type", "", "830.9", &pb.Measurement_Other{Other: "830.9"}},
+		{"not a number", "Ea", "WOODS", &pb.Measurement_Other{Other: "WOODS"}},
+		{"none", "Ea", "", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &Measurement{Id: 1, FileName: "a.csv", IdSat: 2, Timestamp: ts, IonoIndex: 0.1234567891, SpecificMeasurement: tt.specific, Version: 3}
+			got := m.Protobuf(tt.satType)
+			if specific := got.GetSpecific(); tt.want == nil && specific != nil || tt.want != nil && !reflect.DeepEqual(specific, tt.want) {
+				t.Errorf("Protobuf(%q) specific = %v, want %v", tt.satType, specific, tt.want)
+			}
+			// doubles keep the precision of the stored values
+			if back := NewMeasurement(got); !reflect.DeepEqual(back, m) {
+				t.Errorf("NewMeasurement() = %v, want %v", back, m)
+			}
+		})
+	}
+}
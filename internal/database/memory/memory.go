@@ -0,0 +1,688 @@
+// Package memory implements database.Store in memory, for tests and demos.
+package memory
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Simek13/satelliteApp/internal/database"
+	"github.com/Simek13/satelliteApp/internal/satellites"
+	"github.com/pkg/errors"
+)
+
+// Store is an in-memory database.Store with the same semantics as
+// database.SQLDatabase. It is safe for concurrent use.
+type Store struct {
+	mu sync.RWMutex
+	st state
+}
+
+var _ database.Store = (*Store)(nil)
+
+func New() *Store {
+	return &Store{}
+}
+
+// state holds the stored rows. Ids are assigned from a per table sequence
+// starting at 1, like auto increment columns.
+type state struct {
+	satellites   []database.Satellite
+	measurements []database.Measurement
+	computations []database.Computation
+	runs         []database.IngestRun
+
+	satelliteSeq, measurementSeq, computationSeq, runSeq int
+}
+
+func (st *state) clone() state {
+	c := *st
+	c.satellites = append([]database.Satellite(nil), st.satellites...)
+	c.measurements = append([]database.Measurement(nil), st.measurements...)
+	c.computations = append([]database.Computation(nil), st.computations...)
+	c.runs = append([]database.IngestRun(nil), st.runs...)
+	return c
+}
+
+// update applies fn to a copy of the state and keeps the copy only if fn
+// succeeds, so every write is all or nothing like a transaction.
+func (s *Store) update(fn func(st *state) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.st.clone()
+	if err := fn(&st); err != nil {
+		return err
+	}
+	s.st = st
+	return nil
+}
+
+func duplicate(format string, args ...interface{}) error {
+	return &database.Error{Kind: database.ErrDuplicate, Err: errors.Errorf(format, args...)}
+}
+
+func notFound(idSat int) error {
+	return database.NotFound("satellites", idSat, errors.Errorf("satellite %d does not exist", idSat))
+}
+
+func foreignKey(idSat int) error {
+	return &database.Error{Kind: database.ErrForeignKey, Err: errors.Errorf("satellite %d does not exist", idSat)}
+}
+
+func (st *state) satelliteId(name string) int {
+	for _, s := range st.satellites {
+		if s.Name == name {
+			return s.Id
+		}
+	}
+	return 0
+}
+
+func (st *state) satelliteIndex(id int) int {
+	for i, s := range st.satellites {
+		if s.Id == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func (st *state) hasSatellite(id int) bool {
+	return st.satelliteIndex(id) >= 0
+}
+
+func (st *state) hasComputation(idSat int) bool {
+	for _, c := range st.computations {
+		if c.IdSat == idSat {
+			return true
+		}
+	}
+	return false
+}
+
+func (st *state) addSatellite(s *database.Satellite) error {
+	if st.satelliteId(s.Name) != 0 {
+		return duplicate("satellite %q already exists", s.Name)
+	}
+	s.Normalize()
+	st.satelliteSeq++
+	s.Id = st.satelliteSeq
+	st.satellites = append(st.satellites, *s)
+	return nil
+}
+
+func (st *state) addSatellites(sats map[string]satellites.Satellite) (int, error) {
+	added := 0
+	for name, sat := range sats {
+		if i := st.satelliteIndex(st.satelliteId(name)); i >= 0 {
+			if st.satellites[i].Deleted != nil {
+				return added, database.Deleted("satellites", name, errors.Errorf("satellite %q is deleted", name))
+			}
+			continue
+		}
+		if err := st.addSatellite(&database.Satellite{Name: name, Type: sat.GetSatellite().SatelliteType.String()}); err != nil {
+			return added, errors.Wrap(err, "Unable to insert satellite into database")
+		}
+		added++
+	}
+	return added, nil
+}
+
+func (st *state) addMeasurement(m *database.Measurement) error {
+	if !st.hasSatellite(m.IdSat) {
+		return foreignKey(m.IdSat)
+	}
+	st.measurementSeq++
+	m.Id = st.measurementSeq
+	m.Version = 1
+	st.measurements = append(st.measurements, *m)
+	return nil
+}
+
+func (st *state) addMeasurements(filename string, sats map[string]satellites.Satellite) (int, error) {
+	added := 0
+	for name, sat := range sats {
+		for _, m := range database.MeasurementsOf(filename, st.satelliteId(name), sat) {
+			if err := st.addMeasurement(m); err != nil {
+				return added, errors.Wrap(err, "Unable to insert measurements into database")
+			}
+			added++
+		}
+	}
+	return added, nil
+}
+
+func (st *state) addComputation(c *database.Computation) error {
+	if !st.hasSatellite(c.IdSat) {
+		return foreignKey(c.IdSat)
+	}
+	if st.hasComputation(c.IdSat) {
+		return duplicate("computation of satellite %d already exists", c.IdSat)
+	}
+	st.computationSeq++
+	c.Id = st.computationSeq
+	c.Version = 1
+	st.computations = append(st.computations, *c)
+	return nil
+}
+
+func (st *state) addComputations(sats map[string]satellites.Satellite) (int, error) {
+	added := 0
+	for name, sat := range sats {
+		idSat := st.satelliteId(name)
+		if st.hasComputation(idSat) {
+			continue
+		}
+		if err := st.addComputation(database.ComputationOf(idSat, sat)); err != nil {
+			return added, errors.Wrap(err, "Unable to insert computation into database")
+		}
+		added++
+	}
+	return added, nil
+}
+
+func (s *Store) AddSatellite(sat *database.Satellite) error {
+	return s.update(func(st *state) error {
+		return st.addSatellite(sat)
+	})
+}
+
+func (s *Store) AddSatellites(sats map[string]satellites.Satellite) error {
+	return s.update(func(st *state) error {
+		_, err := st.addSatellites(sats)
+		return err
+	})
+}
+
+func (s *Store) GetSatelliteId(name string) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.st.satelliteId(name), nil
+}
+
+func (s *Store) GetSatellite(id int) (*database.Satellite, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, sat := range s.st.satellites {
+		if sat.Id == id {
+			return &sat, nil
+		}
+	}
+	return nil, notFound(id)
+}
+
+func (s *Store) GetSatelliteByName(name string) (*database.Satellite, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, sat := range s.st.satellites {
+		if sat.Name == name {
+			return &sat, nil
+		}
+	}
+	return nil, database.NotFound("satellites", name, errors.Errorf("satellite %q does not exist", name))
+}
+
+func (s *Store) ListSatellites(q database.SatelliteQuery, page database.Page) ([]database.Satellite, string, error) {
+	after, err := page.Cursor()
+	if err != nil {
+		return nil, "", err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sats := make([]database.Satellite, 0)
+	for _, sat := range s.st.satellites {
+		if !q.Match(sat) || !after.AfterSatellite(sat) {
+			continue
+		}
+		sats = append(sats, sat)
+	}
+	if page.Descending {
+		for i, j := 0, len(sats)-1; i < j; i, j = i+1, j-1 {
+			sats[i], sats[j] = sats[j], sats[i]
+		}
+	}
+
+	if page.Size > 0 && len(sats) > page.Size {
+		sats = sats[:page.Size]
+		return sats, database.SatelliteCursor(sats[page.Size-1], page.Descending).Token(), nil
+	}
+	return sats, "", nil
+}
+
+func (s *Store) UpdateSatellite(sat *database.Satellite, columns []string) error {
+	return s.update(func(st *state) error {
+		i := st.satelliteIndex(sat.Id)
+		if i < 0 {
+			return notFound(sat.Id)
+		}
+		sat.Normalize()
+		row := st.satellites[i]
+		for _, column := range columns {
+			setSatelliteColumn(&row, *sat, column)
+		}
+		if id := st.satelliteId(row.Name); id != 0 && id != row.Id {
+			return duplicate("satellite %q already exists", row.Name)
+		}
+		st.satellites[i] = row
+		*sat = row
+		return nil
+	})
+}
+
+func setSatelliteColumn(dst *database.Satellite, src database.Satellite, column string) {
+	switch column {
+	case "name":
+		dst.Name = src.Name
+	case "satType":
+		dst.Type = src.Type
+	case "description":
+		dst.Description = src.Description
+	case "mission":
+		dst.Mission = src.Mission
+	case "operator":
+		dst.Operator = src.Operator
+	case "launchDate":
+		dst.LaunchDate = src.LaunchDate
+	case "status":
+		dst.Status = src.Status
+	case "tags":
+		dst.Tags = src.Tags
+	}
+}
+
+func (s *Store) DeleteSatellite(id int, cascade bool) error {
+	return s.update(func(st *state) error {
+		i := st.satelliteIndex(id)
+		if i < 0 {
+			return notFound(id)
+		}
+		measurements := st.measurements[:0]
+		for _, m := range st.measurements {
+			if m.IdSat != id {
+				measurements = append(measurements, m)
+			}
+		}
+		computations := st.computations[:0]
+		for _, c := range st.computations {
+			if c.IdSat != id {
+				computations = append(computations, c)
+			}
+		}
+		if !cascade && (len(measurements) != len(st.measurements) || len(computations) != len(st.computations)) {
+			return &database.Error{Kind: database.ErrForeignKey, Err: errors.Errorf("satellite %d has measurements or computations", id)}
+		}
+		st.measurements, st.computations = measurements, computations
+		st.satellites = append(st.satellites[:i], st.satellites[i+1:]...)
+		return nil
+	})
+}
+
+func (s *Store) SoftDeleteSatellite(id int) error {
+	return s.update(func(st *state) error {
+		i := st.satelliteIndex(id)
+		if i < 0 {
+			return notFound(id)
+		}
+		if st.satellites[i].Deleted == nil {
+			deleted := time.Now().UTC()
+			st.satellites[i].Deleted = &deleted
+		}
+		return nil
+	})
+}
+
+func (s *Store) AddMeasurement(m *database.Measurement) error {
+	return s.update(func(st *state) error {
+		return st.addMeasurement(m)
+	})
+}
+
+func (s *Store) AddMeasurements(filename string, sats map[string]satellites.Satellite) error {
+	return s.update(func(st *state) error {
+		_, err := st.addMeasurements(filename, sats)
+		return err
+	})
+}
+
+// BulkAddMeasurements stores ms all or nothing. Like the SQL implementation
+// it does not set the ids of ms.
+func (s *Store) BulkAddMeasurements(ms []*database.Measurement) error {
+	return s.update(func(st *state) error {
+		for _, m := range ms {
+			row := *m
+			if err := st.addMeasurement(&row); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *Store) GetMeasurements(satId int) ([]database.Measurement, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	measurements := make([]database.Measurement, 0)
+	for _, m := range s.st.measurements {
+		if satId == 0 || m.IdSat == satId {
+			measurements = append(measurements, m)
+		}
+	}
+	return measurements, nil
+}
+
+func (s *Store) MeasurementsAfter(id, limit int) ([]database.Measurement, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	// measurements are appended in id order
+	measurements := make([]database.Measurement, 0)
+	for _, m := range s.st.measurements {
+		if len(measurements) == limit {
+			break
+		}
+		if m.Id > id {
+			measurements = append(measurements, m)
+		}
+	}
+	return measurements, nil
+}
+
+func (s *Store) LastMeasurementId() (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if len(s.st.measurements) == 0 {
+		return 0, nil
+	}
+	return s.st.measurements[len(s.st.measurements)-1].Id, nil
+}
+
+func (s *Store) GetMeasurementsBetween(satId int, start, end time.Time) ([]database.Measurement, error) {
+	measurements, _, err := s.ListMeasurements(database.MeasurementQuery{SatId: satId, Start: start, End: end}, database.Page{})
+	return measurements, err
+}
+
+func (s *Store) ListMeasurements(q database.MeasurementQuery, page database.Page) ([]database.Measurement, string, error) {
+	after, err := page.Cursor()
+	if err != nil {
+		return nil, "", err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	measurements := make([]database.Measurement, 0)
+	for _, m := range s.st.measurements {
+		if q.SatId != 0 && m.IdSat != q.SatId ||
+			!q.Start.IsZero() && m.Timestamp.Before(q.Start) ||
+			!q.End.IsZero() && !m.Timestamp.Before(q.End) ||
+			!after.AfterMeasurement(m) {
+			continue
+		}
+		measurements = append(measurements, m)
+	}
+	sort.Slice(measurements, func(i, j int) bool {
+		a, b := measurements[i], measurements[j]
+		less := a.Timestamp.Before(b.Timestamp) || a.Timestamp.Equal(b.Timestamp) && a.Id < b.Id
+		return less != page.Descending
+	})
+
+	if page.Size > 0 && len(measurements) > page.Size {
+		measurements = measurements[:page.Size]
+		return measurements, database.MeasurementCursor(measurements[page.Size-1], page.Descending).Token(), nil
+	}
+	return measurements, "", nil
+}
+
+func (s *Store) AddComputation(c *database.Computation) error {
+	return s.update(func(st *state) error {
+		return st.addComputation(c)
+	})
+}
+
+func (s *Store) AddComputations(sats map[string]satellites.Satellite) error {
+	return s.update(func(st *state) error {
+		_, err := st.addComputations(sats)
+		return err
+	})
+}
+
+func (s *Store) GetComputations(satId int) ([]database.Computation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	computations := make([]database.Computation, 0)
+	for _, c := range s.st.computations {
+		if satId == 0 || c.IdSat == satId {
+			computations = append(computations, c)
+		}
+	}
+	return computations, nil
+}
+
+func (s *Store) Ingest(filename string, sats map[string]satellites.Satellite) (*database.IngestRun, error) {
+	run := &database.IngestRun{FileName: filename}
+	err := s.update(func(st *state) error {
+		var err error
+		if run.Satellites, err = st.addSatellites(sats); err != nil {
+			return err
+		}
+		if run.Measurements, err = st.addMeasurements(filename, sats); err != nil {
+			return err
+		}
+		if run.Computations, err = st.addComputations(sats); err != nil {
+			return err
+		}
+		st.addRun(run)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to ingest file, no data was written")
+	}
+	return run, nil
+}
+
+func (s *Store) IngestMeasurements(filename string, ms []*database.Measurement) (*database.IngestRun, error) {
+	run := &database.IngestRun{FileName: filename, Measurements: len(ms)}
+	err := s.update(func(st *state) error {
+		for _, m := range ms {
+			row := *m
+			if err := st.addMeasurement(&row); err != nil {
+				return errors.Wrap(err, "Unable to insert measurements into database")
+			}
+		}
+		st.addRun(run)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to ingest measurements, no data was written")
+	}
+	return run, nil
+}
+
+func (st *state) addRun(run *database.IngestRun) {
+	st.runSeq++
+	run.Id = st.runSeq
+	run.Created = time.Now().UTC()
+	st.runs = append(st.runs, *run)
+}
+
+func (s *Store) UpdateMeasurement(m *database.Measurement, columns []string) error {
+	return s.update(func(st *state) error {
+		i, err := st.measurementIndex(m.Id, m.Version)
+		if err != nil {
+			return err
+		}
+		row := st.measurements[i]
+		for _, column := range columns {
+			setMeasurementColumn(&row, *m, column)
+		}
+		if !st.hasSatellite(row.IdSat) {
+			return foreignKey(row.IdSat)
+		}
+		row.Version++
+		st.measurements[i] = row
+		*m = row
+		return nil
+	})
+}
+
+func (s *Store) DeleteMeasurement(id, version int) error {
+	return s.update(func(st *state) error {
+		i, err := st.measurementIndex(id, version)
+		if err != nil {
+			return err
+		}
+		st.measurements = append(st.measurements[:i], st.measurements[i+1:]...)
+		return nil
+	})
+}
+
+func (s *Store) UpdateComputation(c *database.Computation, columns []string) error {
+	return s.update(func(st *state) error {
+		i, err := st.computationIndex(c.Id, c.Version)
+		if err != nil {
+			return err
+		}
+		row := st.computations[i]
+		for _, column := range columns {
+			setComputationColumn(&row, *c, column)
+		}
+		if !st.hasSatellite(row.IdSat) {
+			return foreignKey(row.IdSat)
+		}
+		if row.IdSat != st.computations[i].IdSat && st.hasComputation(row.IdSat) {
+			return duplicate("computation of satellite %d already exists", row.IdSat)
+		}
+		row.Version++
+		st.computations[i] = row
+		*c = row
+		return nil
+	})
+}
+
+func (s *Store) DeleteComputation(id, version int) error {
+	return s.update(func(st *state) error {
+		i, err := st.computationIndex(id, version)
+		if err != nil {
+			return err
+		}
+		st.computations = append(st.computations[:i], st.computations[i+1:]...)
+		return nil
+	})
+}
+
+// measurementIndex returns the index of measurement id, failing unless it is
+// at version or version is 0.
+func (st *state) measurementIndex(id, version int) (int, error) {
+	for i, m := range st.measurements {
+		if m.Id == id {
+			return i, checkVersion("measurements", id, version, m.Version)
+		}
+	}
+	return -1, database.NotFound("measurements", id, errors.Errorf("row %d of measurements does not exist", id))
+}
+
+func (st *state) computationIndex(id, version int) (int, error) {
+	for i, c := range st.computations {
+		if c.Id == id {
+			return i, checkVersion("computations", id, version, c.Version)
+		}
+	}
+	return -1, database.NotFound("computations", id, errors.Errorf("row %d of computations does not exist", id))
+}
+
+func checkVersion(table string, id, version, current int) error {
+	if version != 0 && version != current {
+		return &database.Error{Kind: database.ErrVersionConflict, Err: errors.Errorf("row %d of %s is not at version %d", id, table, version), Resource: table, Key: strconv.Itoa(id)}
+	}
+	return nil
+}
+
+func setMeasurementColumn(dst *database.Measurement, src database.Measurement, column string) {
+	switch column {
+	case "filename":
+		dst.FileName = src.FileName
+	case "idSat":
+		dst.IdSat = src.IdSat
+	case "timestamp":
+		dst.Timestamp = src.Timestamp.UTC()
+	case "ionoIndex":
+		dst.IonoIndex = src.IonoIndex
+	case "ndviIndex":
+		dst.NdviIndex = src.NdviIndex
+	case "radiationIndex":
+		dst.RadiationIndex = src.RadiationIndex
+	case "specificMeasurement":
+		dst.SpecificMeasurement = src.SpecificMeasurement
+	}
+}
+
+func setComputationColumn(dst *database.Computation, src database.Computation, column string) {
+	switch column {
+	case "idSat":
+		dst.IdSat = src.IdSat
+	case "duration":
+		dst.Duration = src.Duration
+	case "maxIono":
+		dst.MaxIono = src.MaxIono
+	case "minIono":
+		dst.MinIono = src.MinIono
+	case "avgIono":
+		dst.AvgIono = src.AvgIono
+	case "maxNdvi":
+		dst.MaxNdvi = src.MaxNdvi
+	case "minNdvi":
+		dst.MinNdvi = src.MinNdvi
+	case "avgNdvi":
+		dst.AvgNdvi = src.AvgNdvi
+	case "maxRad":
+		dst.MaxRad = src.MaxRad
+	case "minRad":
+		dst.MinRad = src.MinRad
+	case "avgRad":
+		dst.AvgRad = src.AvgRad
+	case "maxSpec":
+		dst.MaxSpec = src.MaxSpec
+	case "minSpec":
+		dst.MinSpec = src.MinSpec
+	case "avgSpec":
+		dst.AvgSpec = src.AvgSpec
+	}
+}
+
+func (s *Store) ListComputations(satId int, page database.Page) ([]database.Computation, string, error) {
+	after, err := page.Cursor()
+	if err != nil {
+		return nil, "", err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	computations := make([]database.Computation, 0)
+	for _, c := range s.st.computations {
+		if satId != 0 && c.IdSat != satId || !after.AfterComputation(c) {
+			continue
+		}
+		computations = append(computations, c)
+	}
+	if page.Descending {
+		for i, j := 0, len(computations)-1; i < j; i, j = i+1, j-1 {
+			computations[i], computations[j] = computations[j], computations[i]
+		}
+	}
+
+	if page.Size > 0 && len(computations) > page.Size {
+		computations = computations[:page.Size]
+		return computations, database.ComputationCursor(computations[page.Size-1], page.Descending).Token(), nil
+	}
+	return computations, "", nil
+}
@@ -0,0 +1,293 @@
+package database
+
+import (
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/pkg/errors"
+)
+
+// migrationTable has the layout used by golang-migrate, so databases migrated
+// with either tool can be managed by the other.
+const migrationTable = "schema_migrations"
+
+// nilVersion is stored by golang-migrate for a dirty database without any
+// applied migration.
+const nilVersion = -1
+
+var (
+	ErrSchemaDirty   = errors.New("database schema is dirty")
+	ErrSchemaVersion = errors.New("database schema version does not match")
+)
+
+var migrationFile = regexp.MustCompile(`^([0-9]+)_(.*)\.(up|down)\.sql$`)
+
+// Migration is one schema change and the way to revert it.
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationStatus tells whether a migration has been applied.
+type MigrationStatus struct {
+	Migration
+	Applied bool
+}
+
+// LoadMigrations reads the migrations in the root of fsys. Files are named
+// like golang-migrate's, <version>_<name>.up.sql and <version>_<name>.down.sql.
+// The migrations are returned in version order.
+func LoadMigrations(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, errors.Wrap(err, "Error reading migrations")
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, e := range entries {
+		match := migrationFile.FindStringSubmatch(e.Name())
+		if e.IsDir() || match == nil {
+			continue
+		}
+		version, err := strconv.Atoi(match[1])
+		if err != nil || version <= 0 {
+			return nil, errors.Errorf("invalid migration version in %s", e.Name())
+		}
+		content, err := fs.ReadFile(fsys, e.Name())
+		if err != nil {
+			return nil, errors.Wrap(err, "Error reading migrations")
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		} else if m.Name != match[2] {
+			return nil, errors.Errorf("migration %d has two names, %s and %s", version, m.Name, match[2])
+		}
+		if match[3] == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// Migrator applies and reverts schema migrations, recording the current
+// version in the schema_migrations table.
+type Migrator struct {
+	db         *SQLDatabase
+	migrations []Migration
+}
+
+// NewMigrator returns a migrator of d for the migrations in the root of fsys.
+func NewMigrator(d *SQLDatabase, fsys fs.FS) (*Migrator, error) {
+	migrations, err := LoadMigrations(fsys)
+	if err != nil {
+		return nil, err
+	}
+	if len(migrations) == 0 {
+		return nil, errors.New("no migrations found")
+	}
+	return &Migrator{db: d, migrations: migrations}, nil
+}
+
+// Latest returns the version of the newest migration.
+func (m *Migrator) Latest() int {
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+func (m *Migrator) createTable() error {
+	_, err := m.db.Exec("CREATE TABLE IF NOT EXISTS " + migrationTable + " (version bigint NOT NULL PRIMARY KEY, dirty boolean NOT NULL)")
+	return errors.Wrap(err, "Error creating migrations table")
+}
+
+// Version returns the current schema version, 0 if no migration has been
+// applied, and whether the last migration failed halfway.
+func (m *Migrator) Version() (int, bool, error) {
+	if err := m.createTable(); err != nil {
+		return 0, false, err
+	}
+
+	var row struct {
+		Version int  `db:"version"`
+		Dirty   bool `db:"dirty"`
+	}
+	found, err := m.db.From(migrationTable).Select("version", "dirty").Limit(1).ScanStruct(&row)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "Error reading schema version")
+	}
+	if !found || row.Version == nilVersion {
+		return 0, found && row.Dirty, nil
+	}
+	return row.Version, row.Dirty, nil
+}
+
+func (m *Migrator) setVersion(version int, dirty bool) error {
+	tx, err := m.db.Begin()
+	if err != nil {
+		return err
+	}
+	err = tx.Wrap(func() error {
+		if _, err := tx.Delete(migrationTable).Executor().Exec(); err != nil {
+			return err
+		}
+		if version == 0 && !dirty {
+			return nil
+		}
+		if version == 0 {
+			version = nilVersion
+		}
+		_, err := tx.Insert(migrationTable).Rows(goqu.Record{"version": version, "dirty": dirty}).Executor().Exec()
+		return err
+	})
+	return errors.Wrap(err, "Error writing schema version")
+}
+
+// clean returns the current version, failing if the schema is dirty.
+func (m *Migrator) clean() (int, error) {
+	version, dirty, err := m.Version()
+	if err != nil {
+		return 0, err
+	}
+	if dirty {
+		return 0, errors.Wrapf(ErrSchemaDirty, "migration %d failed, fix the schema and force a version", version)
+	}
+	return version, nil
+}
+
+// run executes the statements of one migration file and marks the schema
+// dirty while doing so. Most databases can not roll back schema changes, so
+// a failed migration leaves the schema dirty until a version is forced.
+func (m *Migrator) run(script string, version, target int) error {
+	if err := m.setVersion(target, true); err != nil {
+		return err
+	}
+	for _, stmt := range statements(script) {
+		if _, err := m.db.Exec(stmt); err != nil {
+			return errors.Wrapf(err, "Error running migration %d", version)
+		}
+	}
+	return m.setVersion(target, false)
+}
+
+// Up applies every migration newer than the current version and returns how
+// many were applied.
+func (m *Migrator) Up() (int, error) {
+	version, err := m.clean()
+	if err != nil {
+		return 0, err
+	}
+
+	applied := 0
+	for _, mg := range m.migrations {
+		if mg.Version <= version {
+			continue
+		}
+		if err := m.run(mg.Up, mg.Version, mg.Version); err != nil {
+			return applied, err
+		}
+		applied++
+	}
+	return applied, nil
+}
+
+// Down reverts the last steps applied migrations and returns how many were
+// reverted.
+func (m *Migrator) Down(steps int) (int, error) {
+	version, err := m.clean()
+	if err != nil {
+		return 0, err
+	}
+
+	reverted := 0
+	for i := len(m.migrations) - 1; i >= 0 && reverted < steps; i-- {
+		mg := m.migrations[i]
+		if mg.Version > version {
+			continue
+		}
+		target := 0
+		if i > 0 {
+			target = m.migrations[i-1].Version
+		}
+		if err := m.run(mg.Down, mg.Version, target); err != nil {
+			return reverted, err
+		}
+		reverted++
+	}
+	return reverted, nil
+}
+
+// Force sets the schema version without running any migration and clears
+// the dirty flag. It is used to recover from a failed migration.
+func (m *Migrator) Force(version int) error {
+	if version < 0 || version > m.Latest() {
+		return errors.Errorf("version is not between 0 and %d", m.Latest())
+	}
+	if err := m.createTable(); err != nil {
+		return err
+	}
+	return m.setVersion(version, false)
+}
+
+// Status returns every migration and whether it has been applied.
+func (m *Migrator) Status() ([]MigrationStatus, error) {
+	version, _, err := m.Version()
+	if err != nil {
+		return nil, err
+	}
+	status := make([]MigrationStatus, 0, len(m.migrations))
+	for _, mg := range m.migrations {
+		status = append(status, MigrationStatus{mg, mg.Version <= version})
+	}
+	return status, nil
+}
+
+// Check returns an error unless the schema is clean and at the latest
+// version.
+func (m *Migrator) Check() error {
+	version, err := m.clean()
+	if err != nil {
+		return err
+	}
+	if version != m.Latest() {
+		return errors.Wrapf(ErrSchemaVersion, "schema is at version %d, want %d", version, m.Latest())
+	}
+	return nil
+}
+
+func (s MigrationStatus) String() string {
+	applied := "pending"
+	if s.Applied {
+		applied = "applied"
+	}
+	return fmt.Sprintf("%06d %s %s", s.Version, applied, s.Name)
+}
+
+// statements splits a migration file into its statements, as not every
+// driver can run several statements at once.
+func statements(script string) []string {
+	stmts := make([]string, 0)
+	for _, s := range strings.Split(script, ";") {
+		if s = strings.TrimSpace(s); s != "" {
+			stmts = append(stmts, s)
+		}
+	}
+	return stmts
+}
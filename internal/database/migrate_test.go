@@ -0,0 +1,172 @@
+package database
+
+import (
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/Simek13/satelliteApp/assets"
+	"github.com/pkg/errors"
+)
+
+func openTestDatabase(t *testing.T) *SQLDatabase {
+	d, err := Open(Config{Type: SQLite, Name: filepath.Join(t.TempDir(), "satellites.db")})
+	if err != nil {
+		t.Fatalf("Error opening database, %v", err)
+	}
+	t.Cleanup(func() { d.Close() })
+	return d
+}
+
+func TestEmbeddedMigrations(t *testing.T) {
+	for _, dbType := range Types {
+		t.Run(dbType, func(t *testing.T) {
+			fsys, err := assets.Migrations(dbType)
+			if err != nil {
+				t.Fatalf("Migrations() error = %v", err)
+			}
+			migrations, err := LoadMigrations(fsys)
+			if err != nil || len(migrations) == 0 {
+				t.Fatalf("LoadMigrations() = %v, %v", migrations, err)
+			}
+			for i, m := range migrations {
+				if m.Version != i+1 || m.Up == "" || m.Down == "" {
+					t.Errorf("migration %d = %d %s, want version %d with up and down", i, m.Version, m.Name, i+1)
+				}
+			}
+		})
+	}
+}
+
+func TestMigrator(t *testing.T) {
+	d := openTestDatabase(t)
+	fsys, _ := assets.Migrations(SQLite)
+	m, err := NewMigrator(d, fsys)
+	if err != nil {
+		t.Fatalf("NewMigrator() error = %v", err)
+	}
+	if err := m.Check(); !errors.Is(err, ErrSchemaVersion) {
+		t.Errorf("Check() on empty database = %v, want %v", err, ErrSchemaVersion)
+	}
+
+	applied, err := m.Up()
+	if err != nil || applied != m.Latest() {
+		t.Fatalf("Up() = %d, %v, want %d", applied, err, m.Latest())
+	}
+	if err := m.Check(); err != nil {
+		t.Errorf("Check() = %v", err)
+	}
+	if applied, err := m.Up(); err != nil || applied != 0 {
+		t.Errorf("Up() again = %d, %v, want 0", applied, err)
+	}
+
+	reverted, err := m.Down(1)
+	if err != nil || reverted != 1 {
+		t.Fatalf("Down(1) = %d, %v, want 1", reverted, err)
+	}
+	if version, dirty, err := m.Version(); err != nil || dirty || version != m.Latest()-1 {
+		t.Errorf("Version() = %d, %v, %v, want %d", version, dirty, err, m.Latest()-1)
+	}
+	status, err := m.Status()
+	if err != nil || len(status) != m.Latest() || !status[0].Applied || status[len(status)-1].Applied {
+		t.Errorf("Status() = %v, %v", status, err)
+	}
+
+	if _, err := m.Down(m.Latest()); err != nil {
+		t.Fatalf("Down() error = %v", err)
+	}
+	if version, dirty, err := m.Version(); err != nil || dirty || version != 0 {
+		t.Errorf("Version() = %d, %v, %v, want 0", version, dirty, err)
+	}
+	if _, err := d.Exec("SELECT * FROM satellites"); err == nil {
+		t.Errorf("satellites table exists after reverting every migration")
+	}
+}
+
+func TestMigratorDirty(t *testing.T) {
+	d := openTestDatabase(t)
+	m, err := NewMigrator(d, fstest.MapFS{
+		"000001_create_a.up.sql":   {Data: []byte("CREATE TABLE a (id int);")},
+		"000001_create_a.down.sql": {Data: []byte("DROP TABLE a;")},
+		"000002_broken.up.sql":     {Data: []byte("CREATE TABLE b (id int); CREATE TABLE a (id int);")},
+		"000002_broken.down.sql":   {Data: []byte("DROP TABLE b;")},
+	})
+	if err != nil {
+		t.Fatalf("NewMigrator() error = %v", err)
+	}
+
+	if applied, err := m.Up(); err == nil || applied != 1 {
+		t.Fatalf("Up() = %d, %v, want 1 and error", applied, err)
+	}
+	if version, dirty, _ := m.Version(); version != 2 || !dirty {
+		t.Errorf("Version() = %d, %v, want dirty version 2", version, dirty)
+	}
+	if _, err := m.Up(); !errors.Is(err, ErrSchemaDirty) {
+		t.Errorf("Up() on dirty schema = %v, want %v", err, ErrSchemaDirty)
+	}
+	if err := m.Check(); !errors.Is(err, ErrSchemaDirty) {
+		t.Errorf("Check() on dirty schema = %v, want %v", err, ErrSchemaDirty)
+	}
+
+	if err := m.Force(2); err != nil {
+		t.Fatalf("Force() error = %v", err)
+	}
+	if err := m.Check(); err != nil {
+		t.Errorf("Check() after Force() = %v", err)
+	}
+}
+
+func TestMigrateTypedTimestamps(t *testing.T) {
+	d := newTestDatabase(t)
+	fsys, _ := assets.Migrations(SQLite)
+	m, _ := NewMigrator(d, fsys)
+	// back to the last version with string timestamps and durations
+	if _, err := m.Down(m.Latest() - 2); err != nil {
+		t.Fatalf("Down() error = %v", err)
+	}
+
+	// rows as written before timestamps and durations were typed
+	for _, stmt := range []string{
+		"INSERT INTO satellites (id, name) VALUES (1, '30J14'), (2, '8J14'), (3, '12J14'), (4, '20J14')",
+		"INSERT INTO measurements VALUES (1, 'a.csv', 1, '2016-02-20 15:21:00 +0000 UTC', 7, 33, 32.5, '833.500000')",
+		"INSERT INTO measurements VALUES (2, 'a.csv', 1, '2016-02-20 17:19:00 +0200 CEST', 5, 29, 32, '830.500000')",
+		// satellite 2 was ingested from two files, its duration is the one of the last file
+		"INSERT INTO measurements VALUES (3, 'a.csv', 2, '2016-02-20 15:00:00 +0000 UTC', 7, 33, 32.5, 'WOODS')",
+		"INSERT INTO measurements VALUES (4, 'b.csv', 2, '2016-02-21 15:00:00 +0000 UTC', 7, 33, 32.5, 'WOODS')",
+		"INSERT INTO measurements VALUES (5, 'b.csv', 2, '2016-02-21 16:02:30 +0000 UTC', 7, 33, 32.5, 'WOODS')",
+		"INSERT INTO computations VALUES (1, 1, '2m0s', 7, 5, 6, 33, 29, 31, 32.5, 32, 32.25, 833.5, 830.5, 832)",
+		"INSERT INTO computations VALUES (2, 2, '1h2m30s', 7, 7, 7, 33, 33, 33, 32.5, 32.5, 32.5, 0, 0, 0)",
+		"INSERT INTO computations VALUES (3, 3, '45.5s', 7, 7, 7, 33, 33, 33, 32.5, 32.5, 32.5, 0, 0, 0)",
+		"INSERT INTO computations VALUES (4, 4, '1.5ms', 7, 7, 7, 33, 33, 33, 32.5, 32.5, 32.5, 0, 0, 0)",
+	} {
+		if _, err := d.Exec(stmt); err != nil {
+			t.Fatalf("Exec() error = %v", err)
+		}
+	}
+	if _, err := m.Up(); err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+
+	measurements, err := d.GetMeasurements(1)
+	if err != nil || len(measurements) != 2 {
+		t.Fatalf("GetMeasurements() = %v, %v", measurements, err)
+	}
+	want := []time.Time{time.Date(2016, 02, 20, 15, 21, 0, 0, time.UTC), time.Date(2016, 02, 20, 15, 19, 0, 0, time.UTC)}
+	for i, m := range measurements {
+		if !m.Timestamp.Equal(want[i]) {
+			t.Errorf("GetMeasurements()[%d] timestamp = %v, want %v", i, m.Timestamp, want[i])
+		}
+	}
+	for idSat, want := range map[int]time.Duration{
+		1: 2 * time.Minute,
+		2: time.Hour + 2*time.Minute + 30*time.Second,
+		3: 45500 * time.Millisecond,
+		4: 1500 * time.Microsecond,
+	} {
+		computations, err := d.GetComputations(idSat)
+		if err != nil || len(computations) != 1 || computations[0].Duration != want {
+			t.Errorf("GetComputations(%d) = %v, %v, want duration %v", idSat, computations, err, want)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package database
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrInvalidPageToken is returned for page tokens that were not returned by
+// the same listing.
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// Page selects one page of a listing.
+type Page struct {
+	// Size is the maximum number of rows returned, zero returns every row.
+	Size int
+	// Token is the next page token returned with the previous page, empty
+	// for the first page.
+	Token string
+	// Descending reverses the order of the listing.
+	Descending bool
+}
+
+// MeasurementQuery selects the measurements of satellite SatId, or of every
+// satellite if SatId is 0, taken in [Start, End). A zero Start or End leaves
+// that side unbounded.
+type MeasurementQuery struct {
+	SatId      int
+	Start, End time.Time
+}
+
+// SatelliteQuery selects satellites. Soft deleted satellites are only listed
+// if ShowDeleted is set, the other fields select the satellites with the
+// given value unless they are zero. Launch dates are selected in
+// [LaunchedAfter, LaunchedBefore).
+type SatelliteQuery struct {
+	ShowDeleted bool
+
+	Type, Mission, Operator, Status string
+	Tag                             string
+
+	LaunchedAfter, LaunchedBefore time.Time
+}
+
+// Match reports whether s is selected by q.
+func (q SatelliteQuery) Match(s Satellite) bool {
+	switch {
+	case s.Deleted != nil && !q.ShowDeleted,
+		q.Type != "" && s.Type != q.Type,
+		q.Mission != "" && s.Mission != q.Mission,
+		q.Operator != "" && s.Operator != q.Operator,
+		q.Status != "" && s.Status != q.Status:
+		return false
+	}
+	if q.Tag != "" {
+		i := sort.SearchStrings(s.Tags, q.Tag)
+		if i == len(s.Tags) || s.Tags[i] != q.Tag {
+			return false
+		}
+	}
+	if !q.LaunchedAfter.IsZero() && (s.LaunchDate == nil || s.LaunchDate.Before(q.LaunchedAfter)) {
+		return false
+	}
+	if !q.LaunchedBefore.IsZero() && (s.LaunchDate == nil || !s.LaunchDate.Before(q.LaunchedBefore)) {
+		return false
+	}
+	return true
+}
+
+// Cursor is the sort key of the last row of a page, from which the next page
+// continues. Measurements are listed by timestamp and id, satellites and
+// computations by id.
+type Cursor struct {
+	Timestamp  time.Time `json:"t,omitempty"`
+	Id         int       `json:"id"`
+	Descending bool      `json:"desc,omitempty"`
+}
+
+func MeasurementCursor(m Measurement, descending bool) Cursor {
+	return Cursor{Timestamp: m.Timestamp.UTC(), Id: m.Id, Descending: descending}
+}
+
+func SatelliteCursor(s Satellite, descending bool) Cursor {
+	return Cursor{Id: s.Id, Descending: descending}
+}
+
+func ComputationCursor(c Computation, descending bool) Cursor {
+	return Cursor{Id: c.Id, Descending: descending}
+}
+
+// Token encodes the cursor as an opaque page token.
+func (c Cursor) Token() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// Cursor decodes the page token of p. It returns nil for the first page.
+func (p Page) Cursor() (*Cursor, error) {
+	if p.Token == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(p.Token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	var c Cursor
+	if err := json.Unmarshal(b, &c); err != nil || c.Id <= 0 {
+		return nil, ErrInvalidPageToken
+	}
+	if c.Descending != p.Descending {
+		return nil, errors.Wrap(ErrInvalidPageToken, "page token is of a listing in the other order")
+	}
+	return &c, nil
+}
+
+// AfterMeasurement reports whether m is listed after the cursor. Every
+// measurement is listed after a nil cursor.
+func (c *Cursor) AfterMeasurement(m Measurement) bool {
+	if c == nil {
+		return true
+	}
+	if !m.Timestamp.Equal(c.Timestamp) {
+		return m.Timestamp.After(c.Timestamp) != c.Descending
+	}
+	return c.idAfter(m.Id)
+}
+
+// AfterSatellite reports whether s is listed after the cursor.
+func (c *Cursor) AfterSatellite(s Satellite) bool {
+	return c == nil || c.idAfter(s.Id)
+}
+
+// AfterComputation reports whether cm is listed after the cursor.
+func (c *Cursor) AfterComputation(cm Computation) bool {
+	return c == nil || c.idAfter(cm.Id)
+}
+
+func (c *Cursor) idAfter(id int) bool {
+	if c.Descending {
+		return id < c.Id
+	}
+	return id > c.Id
+}
@@ -1,18 +1,130 @@
 package database
 
 import (
+	"sort"
+	"time"
+
 	"github.com/Simek13/satelliteApp/internal/satellites"
-	pb "github.com/Simek13/satelliteApp/pkg"
+	pb "github.com/Simek13/satelliteApp/pkg/v2"
 	"github.com/doug-martin/goqu/v9"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Satellite statuses.
+const (
+	SatelliteActive  = "active"
+	SatelliteRetired = "retired"
 )
 
 type Satellite struct {
 	Id   int    `db:"id" goqu:"skipinsert, skipupdate"`
 	Name string `db:"name"`
+	// Type is the satellites.SatType of the satellite, or empty if it is not
+	// known.
+	Type        string `db:"satType"`
+	Description string `db:"description"`
+	Mission     string `db:"mission"`
+	Operator    string `db:"operator"`
+	// LaunchDate is midnight UTC of the launch day, nil if it is not known.
+	LaunchDate *time.Time `db:"launchDate"`
+	Status     string     `db:"status"`
+	// Tags are sorted and stored in the satellite_tags table.
+	Tags []string `db:"-"`
+	// Deleted is when the satellite was soft deleted, nil if it was not.
+	Deleted *time.Time `db:"deleted" goqu:"skipinsert, skipupdate"`
+}
+
+const (
+	satelliteTable    = "satellites"
+	satelliteTagTable = "satellite_tags"
+)
+
+// satelliteFields maps the fields of pb.Satellite that can be updated to
+// their columns. Tags are not a column of the satellites table.
+var satelliteFields = map[string]string{
+	"name":        "name",
+	"type":        "satType",
+	"description": "description",
+	"mission":     "mission",
+	"operator":    "operator",
+	"launch_date": "launchDate",
+	"status":      "status",
+	"tags":        "tags",
+}
+
+// SatelliteColumns returns the columns of the pb.Satellite fields named by
+// paths, or of every field that can be updated if paths is empty.
+func SatelliteColumns(paths []string) ([]string, error) {
+	return columnsOf(satelliteFields, paths)
 }
 
-const satelliteTable = "satellites"
+func (s *Satellite) record() goqu.Record {
+	return goqu.Record{
+		"name":        s.Name,
+		"satType":     s.Type,
+		"description": s.Description,
+		"mission":     s.Mission,
+		"operator":    s.Operator,
+		"launchDate":  s.LaunchDate,
+		"status":      s.Status,
+	}
+}
+
+// Normalize puts s in the form it is stored in: the status defaults to
+// active, the launch date is truncated to its UTC day and the tags are sorted
+// without duplicates.
+func (s *Satellite) Normalize() {
+	if s.Status == "" {
+		s.Status = SatelliteActive
+	}
+	if s.LaunchDate != nil {
+		day := s.LaunchDate.UTC().Truncate(24 * time.Hour)
+		s.LaunchDate = &day
+	}
+	if s.Deleted != nil {
+		deleted := s.Deleted.UTC()
+		s.Deleted = &deleted
+	}
+	if len(s.Tags) == 0 {
+		s.Tags = nil
+		return
+	}
+	tags := append([]string(nil), s.Tags...)
+	sort.Strings(tags)
+	s.Tags = tags[:0]
+	for _, tag := range tags {
+		if len(s.Tags) == 0 || tag != s.Tags[len(s.Tags)-1] {
+			s.Tags = append(s.Tags, tag)
+		}
+	}
+}
+
+// satelliteTypes maps the Type of satellites to the satellite types of the
+// API.
+var satelliteTypes = map[string]pb.SatelliteType{
+	"":                     pb.SatelliteType_SATELLITE_TYPE_UNSPECIFIED,
+	satellites.Ea.String(): pb.SatelliteType_SATELLITE_TYPE_EA,
+	satellites.Vc.String(): pb.SatelliteType_SATELLITE_TYPE_VC,
+	satellites.Ss.String(): pb.SatelliteType_SATELLITE_TYPE_SS,
+}
+
+// SatelliteTypeProtobuf converts the Type of a satellite to a satellite type
+// of the API.
+func SatelliteTypeProtobuf(t string) pb.SatelliteType {
+	return satelliteTypes[t]
+}
+
+// SatelliteTypeOf converts a satellite type of the API to the Type of a
+// satellite. Unknown types are empty.
+func SatelliteTypeOf(t pb.SatelliteType) string {
+	for name, v2 := range satelliteTypes {
+		if v2 == t {
+			return name
+		}
+	}
+	return ""
+}
 
 func (s *Satellite) Protobuf() *pb.Satellite {
 	if s == nil {
@@ -20,8 +132,20 @@ func (s *Satellite) Protobuf() *pb.Satellite {
 	}
 
 	satellite := &pb.Satellite{
-		Id:   int32(s.Id),
-		Name: s.Name,
+		Id:          int32(s.Id),
+		Name:        s.Name,
+		Type:        SatelliteTypeProtobuf(s.Type),
+		Description: s.Description,
+		Mission:     s.Mission,
+		Operator:    s.Operator,
+		Status:      s.Status,
+		Tags:        s.Tags,
+	}
+	if s.LaunchDate != nil {
+		satellite.LaunchDate = timestamppb.New(*s.LaunchDate)
+	}
+	if s.Deleted != nil {
+		satellite.Deleted = timestamppb.New(*s.Deleted)
 	}
 	return satellite
 }
@@ -31,43 +155,278 @@ func NewSatellite(s *pb.Satellite) *Satellite {
 		return nil
 	}
 	satellite := &Satellite{
-		Id:   int(s.Id),
-		Name: s.Name,
+		Id:          int(s.Id),
+		Name:        s.Name,
+		Type:        SatelliteTypeOf(s.Type),
+		Description: s.Description,
+		Mission:     s.Mission,
+		Operator:    s.Operator,
+		Status:      s.Status,
+		Tags:        s.Tags,
+	}
+	if s.LaunchDate != nil {
+		launchDate := s.LaunchDate.AsTime()
+		satellite.LaunchDate = &launchDate
 	}
 	return satellite
 }
 
-func (d *MySQLDatabase) AddSatellite(s *Satellite) error {
+func (d *SQLDatabase) AddSatellite(s *Satellite) error {
 	tx, err := d.Begin()
 	if err != nil {
 		return err
 	}
 
 	return tx.Wrap(func() error {
+		return addSatellite(tx, s)
+	})
+}
 
-		result, err := tx.Insert(satelliteTable).
-			Prepared(true).
-			Rows(s).Executor().
-			Exec()
-		if err != nil {
+func addSatellite(tx *goqu.TxDatabase, s *Satellite) error {
+	s.Normalize()
+	id, err := insert(tx, satelliteTable, s)
+	if err != nil {
+		return err
+	}
+	s.Id = id
+	return writeTags(tx, s.Id, s.Tags)
+}
+
+// writeTags replaces the tags of satellite id.
+func writeTags(tx *goqu.TxDatabase, id int, tags []string) error {
+	if _, err := tx.Delete(satelliteTagTable).Prepared(true).Where(goqu.C("idSat").Eq(id)).Executor().Exec(); err != nil {
+		return errors.Wrap(err, "Unable to delete satellite tags")
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	rows := make([]goqu.Record, 0, len(tags))
+	for _, tag := range tags {
+		rows = append(rows, goqu.Record{"idSat": id, "tag": tag})
+	}
+	if _, err := tx.Insert(satelliteTagTable).Prepared(true).Rows(rows).Executor().Exec(); err != nil {
+		return errors.Wrap(ClassifyError(err), "Unable to insert satellite tags")
+	}
+	return nil
+}
+
+// readTags sets the tags of sats.
+func readTags(db executor, sats []Satellite) error {
+	if len(sats) == 0 {
+		return nil
+	}
+	index := make(map[int]int, len(sats))
+	ids := make([]interface{}, 0, len(sats))
+	for i := range sats {
+		index[sats[i].Id] = i
+		ids = append(ids, sats[i].Id)
+	}
+
+	var tags []struct {
+		IdSat int    `db:"idSat"`
+		Tag   string `db:"tag"`
+	}
+	err := db.From(satelliteTagTable).Prepared(true).
+		Where(goqu.C("idSat").In(ids...)).
+		Order(goqu.C("tag").Asc()).
+		ScanStructs(&tags)
+	if err != nil {
+		return errors.Wrap(err, "Error reading satellite tags")
+	}
+	for _, t := range tags {
+		s := &sats[index[t.IdSat]]
+		s.Tags = append(s.Tags, t.Tag)
+	}
+	return nil
+}
+
+func (d *SQLDatabase) GetSatelliteId(name string) (int, error) {
+	return getSatelliteId(d.Database, name)
+}
+
+func (d *SQLDatabase) GetSatellite(id int) (*Satellite, error) {
+	s, err := getSatellite(d.Database, goqu.C("id").Eq(id))
+	if errors.Is(err, ErrNotFound) {
+		return nil, NotFound(satelliteTable, id, errors.Errorf("satellite %d does not exist", id))
+	}
+	return s, err
+}
+
+func (d *SQLDatabase) GetSatelliteByName(name string) (*Satellite, error) {
+	s, err := getSatellite(d.Database, goqu.C("name").Eq(name))
+	if errors.Is(err, ErrNotFound) {
+		return nil, NotFound(satelliteTable, name, errors.Errorf("satellite %q does not exist", name))
+	}
+	return s, err
+}
+
+func getSatellite(db executor, where goqu.Expression) (*Satellite, error) {
+	var s Satellite
+	found, err := db.From(satelliteTable).Prepared(true).Where(where).ScanStruct(&s)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error reading satellite")
+	}
+	if !found {
+		return nil, ErrNotFound
+	}
+	sats := []Satellite{s}
+	if err := readTags(db, sats); err != nil {
+		return nil, err
+	}
+	sats[0].Normalize()
+	return &sats[0], nil
+}
+
+func satelliteExists(db executor, id int) error {
+	_, err := getSatellite(db, goqu.C("id").Eq(id))
+	if errors.Is(err, ErrNotFound) {
+		return NotFound(satelliteTable, id, errors.Errorf("satellite %d does not exist", id))
+	}
+	return err
+}
+
+func (d *SQLDatabase) ListSatellites(q SatelliteQuery, page Page) ([]Satellite, string, error) {
+	after, err := page.Cursor()
+	if err != nil {
+		return nil, "", err
+	}
+
+	ds := d.From(satelliteTable).Prepared(true)
+	if !q.ShowDeleted {
+		ds = ds.Where(goqu.C("deleted").IsNull())
+	}
+	for column, value := range map[string]string{"satType": q.Type, "mission": q.Mission, "operator": q.Operator, "status": q.Status} {
+		if value != "" {
+			ds = ds.Where(goqu.C(column).Eq(value))
+		}
+	}
+	if q.Tag != "" {
+		// a satellite has a tag at most once, so the join does not repeat rows
+		ds = ds.Join(goqu.T(satelliteTagTable), goqu.On(goqu.I(satelliteTagTable+".idSat").Eq(goqu.I(satelliteTable+".id")))).
+			Where(goqu.I(satelliteTagTable + ".tag").Eq(q.Tag))
+	}
+	if !q.LaunchedAfter.IsZero() {
+		ds = ds.Where(goqu.C("launchDate").Gte(q.LaunchedAfter.UTC()))
+	}
+	if !q.LaunchedBefore.IsZero() {
+		ds = ds.Where(goqu.C("launchDate").Lt(q.LaunchedBefore.UTC()))
+	}
+	if page.Descending {
+		ds = ds.Order(goqu.C("id").Desc())
+	} else {
+		ds = ds.Order(goqu.C("id").Asc())
+	}
+	if after != nil {
+		if page.Descending {
+			ds = ds.Where(goqu.C("id").Lt(after.Id))
+		} else {
+			ds = ds.Where(goqu.C("id").Gt(after.Id))
+		}
+	}
+	if page.Size > 0 {
+		ds = ds.Limit(uint(page.Size + 1))
+	}
+
+	sats := make([]Satellite, 0)
+	if err := ds.ScanStructs(&sats); err != nil {
+		return nil, "", errors.Wrap(err, "Error executing sql query")
+	}
+	if err := readTags(d.Database, sats); err != nil {
+		return nil, "", err
+	}
+	for i := range sats {
+		sats[i].Normalize()
+	}
+
+	next := ""
+	if page.Size > 0 && len(sats) > page.Size {
+		sats = sats[:page.Size]
+		next = SatelliteCursor(sats[page.Size-1], page.Descending).Token()
+	}
+	return sats, next, nil
+}
+
+func (d *SQLDatabase) UpdateSatellite(s *Satellite, columns []string) error {
+	tx, err := d.Begin()
+	if err != nil {
+		return err
+	}
+
+	return tx.Wrap(func() error {
+		if err := satelliteExists(tx, s.Id); err != nil {
 			return err
 		}
-		id, err := result.LastInsertId()
+		s.Normalize()
+		record := recordOf(s.record(), columns)
+		if _, ok := record["tags"]; ok {
+			delete(record, "tags")
+			if err := writeTags(tx, s.Id, s.Tags); err != nil {
+				return err
+			}
+		}
+		if len(record) > 0 {
+			_, err := tx.Update(satelliteTable).Prepared(true).
+				Set(record).
+				Where(goqu.C("id").Eq(s.Id)).Executor().Exec()
+			if err != nil {
+				return ClassifyError(err)
+			}
+		}
+		stored, err := getSatellite(tx, goqu.C("id").Eq(s.Id))
 		if err != nil {
 			return err
 		}
-		s.Id = int(id)
+		*s = *stored
 		return nil
 	})
 }
 
-func (d *MySQLDatabase) GetSatelliteId(name string) (int, error) {
-	sql, _, err := d.From(satelliteTable).Select("id").Where(goqu.C("name").Eq(name)).ToSQL()
+func (d *SQLDatabase) DeleteSatellite(id int, cascade bool) error {
+	tx, err := d.Begin()
+	if err != nil {
+		return err
+	}
+
+	return tx.Wrap(func() error {
+		if err := satelliteExists(tx, id); err != nil {
+			return err
+		}
+		if cascade {
+			for _, table := range []string{measurementTable, computationTable} {
+				if _, err := tx.Delete(table).Prepared(true).Where(goqu.C("idSat").Eq(id)).Executor().Exec(); err != nil {
+					return errors.Wrapf(err, "Unable to delete from %s", table)
+				}
+			}
+		}
+		_, err := tx.Delete(satelliteTable).Prepared(true).Where(goqu.C("id").Eq(id)).Executor().Exec()
+		return ClassifyError(err)
+	})
+}
+
+func (d *SQLDatabase) SoftDeleteSatellite(id int) error {
+	tx, err := d.Begin()
+	if err != nil {
+		return err
+	}
+
+	return tx.Wrap(func() error {
+		if err := satelliteExists(tx, id); err != nil {
+			return err
+		}
+		_, err := tx.Update(satelliteTable).Prepared(true).
+			Set(goqu.Record{"deleted": time.Now().UTC()}).
+			Where(goqu.C("id").Eq(id), goqu.C("deleted").IsNull()).Executor().Exec()
+		return ClassifyError(err)
+	})
+}
+
+func getSatelliteId(db executor, name string) (int, error) {
+	sql, _, err := db.From(satelliteTable).Select("id").Where(goqu.C("name").Eq(name)).ToSQL()
 
 	if err != nil {
 		return -1, errors.Wrap(err, "Error generating sql")
 	}
-	rows, err := d.Query(sql)
+	rows, err := db.Query(sql)
 	if err != nil {
 		return -1, errors.Wrap(err, "Error executing sql query")
 	}
@@ -87,15 +446,39 @@ func (d *MySQLDatabase) GetSatelliteId(name string) (int, error) {
 	return idSat, nil
 }
 
-func (d *MySQLDatabase) AddSatellites(sats map[string]satellites.Satellite) error {
-	for name := range sats {
-		s := &Satellite{Name: name}
-		err := d.AddSatellite(s)
+func (d *SQLDatabase) AddSatellites(sats map[string]satellites.Satellite) error {
+	tx, err := d.Begin()
+	if err != nil {
+		return err
+	}
+
+	return tx.Wrap(func() error {
+		_, err := addSatellites(tx, sats)
+		return err
+	})
+}
 
-		err = HandleSqlError(err)
+// addSatellites inserts the satellites that are not stored yet and returns
+// how many were inserted. A satellite inserted by a concurrent transaction fails
+// the insert with ErrDuplicate, which aborts the transaction.
+func addSatellites(tx *goqu.TxDatabase, sats map[string]satellites.Satellite) (int, error) {
+	added := 0
+	for name, sat := range sats {
+		stored, err := getSatellite(tx, goqu.C("name").Eq(name))
+		switch {
+		case errors.Is(err, ErrNotFound):
+		case err != nil:
+			return added, err
+		case stored.Deleted != nil:
+			return added, Deleted(satelliteTable, name, errors.Errorf("satellite %q is deleted", name))
+		default:
+			continue
+		}
+		err = addSatellite(tx, &Satellite{Name: name, Type: sat.GetSatellite().SatelliteType.String()})
 		if err != nil {
-			return errors.Wrap(err, "Unable to insert satellite into database")
+			return added, errors.Wrap(err, "Unable to insert satellite into database")
 		}
+		added++
 	}
-	return nil
+	return added, nil
 }
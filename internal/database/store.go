@@ -0,0 +1,121 @@
+package database
+
+import (
+	"time"
+
+	"github.com/Simek13/satelliteApp/internal/satellites"
+)
+
+// SatelliteStore stores satellites by unique name. Soft deleted satellites
+// keep their name, measurements and computations, and are still returned by
+// the getters.
+type SatelliteStore interface {
+	// AddSatellite normalizes and stores s and sets its id. Adding a name
+	// that is already stored fails with ErrDuplicate.
+	AddSatellite(s *Satellite) error
+	// AddSatellites stores the satellites that are not stored yet. A soft
+	// deleted satellite fails with ErrInvalid.
+	AddSatellites(sats map[string]satellites.Satellite) error
+	// GetSatelliteId returns the id of the named satellite, or 0 if it is
+	// not stored.
+	GetSatelliteId(name string) (int, error)
+	// GetSatellite returns the satellite with the id. An unknown id fails
+	// with ErrNotFound.
+	GetSatellite(id int) (*Satellite, error)
+	// GetSatelliteByName returns the named satellite. An unknown name fails
+	// with ErrNotFound.
+	GetSatelliteByName(name string) (*Satellite, error)
+	// ListSatellites returns one page of the satellites selected by q,
+	// ordered by id, and the token of the next page. The token is empty on
+	// the last page.
+	ListSatellites(q SatelliteQuery, page Page) ([]Satellite, string, error)
+	// UpdateSatellite writes the columns of s to satellite s.Id and sets s to
+	// the stored satellite. An unknown id fails with ErrNotFound, a name that
+	// is already stored with ErrDuplicate.
+	UpdateSatellite(s *Satellite, columns []string) error
+	// DeleteSatellite deletes satellite id. Unless cascade is set, deleting
+	// a satellite that has measurements or computations fails with
+	// ErrForeignKey. An unknown id fails with ErrNotFound.
+	DeleteSatellite(id int, cascade bool) error
+	// SoftDeleteSatellite marks satellite id as deleted. Deleting a deleted
+	// satellite succeeds and keeps the time it was first deleted. An unknown
+	// id fails with ErrNotFound.
+	SoftDeleteSatellite(id int) error
+}
+
+// MeasurementStore stores the measurements of stored satellites.
+type MeasurementStore interface {
+	// AddMeasurement stores m and sets its id. A measurement of an unknown
+	// satellite fails with ErrForeignKey.
+	AddMeasurement(m *Measurement) error
+	// AddMeasurements stores every measurement of the satellites, which must
+	// already be stored.
+	AddMeasurements(filename string, sats map[string]satellites.Satellite) error
+	// BulkAddMeasurements stores ms all or nothing. Ids are not set.
+	BulkAddMeasurements(ms []*Measurement) error
+	// GetMeasurements returns the measurements of satellite satId, or of every
+	// satellite if satId is 0, ordered by id.
+	GetMeasurements(satId int) ([]Measurement, error)
+	// GetMeasurementsBetween returns the measurements of satellite satId, or
+	// of every satellite if satId is 0, taken in [start, end), ordered by
+	// timestamp and id. A zero start or end leaves that side unbounded.
+	GetMeasurementsBetween(satId int, start, end time.Time) ([]Measurement, error)
+	// ListMeasurements returns one page of the measurements selected by q,
+	// ordered by timestamp and id, and the token of the next page. The token
+	// is empty on the last page.
+	ListMeasurements(q MeasurementQuery, page Page) ([]Measurement, string, error)
+	// MeasurementsAfter returns at most limit measurements with an id
+	// greater than id, ordered by id.
+	MeasurementsAfter(id, limit int) ([]Measurement, error)
+	// LastMeasurementId returns the greatest id of a stored measurement, or 0
+	// if there is none.
+	LastMeasurementId() (int, error)
+	// UpdateMeasurement writes the columns of m to measurement m.Id,
+	// increments its version and sets m to the stored measurement. Unless
+	// m.Version is 0 it must be the stored version, or the update fails with
+	// ErrVersionConflict. An unknown id fails with ErrNotFound.
+	UpdateMeasurement(m *Measurement, columns []string) error
+	// DeleteMeasurement deletes measurement id, checking version like
+	// UpdateMeasurement.
+	DeleteMeasurement(id, version int) error
+}
+
+// ComputationStore stores one computation per stored satellite.
+type ComputationStore interface {
+	// AddComputation stores c and sets its id. A second computation of the
+	// same satellite fails with ErrDuplicate, one of an unknown satellite
+	// with ErrForeignKey.
+	AddComputation(c *Computation) error
+	// AddComputations stores the computations of satellites that have none
+	// stored yet.
+	AddComputations(sats map[string]satellites.Satellite) error
+	// GetComputations returns the computations of satellite satId, or of
+	// every satellite if satId is 0, ordered by id.
+	GetComputations(satId int) ([]Computation, error)
+	// ListComputations returns one page of the computations of satellite
+	// satId, or of every satellite if satId is 0, ordered by id, and the
+	// token of the next page. The token is empty on the last page.
+	ListComputations(satId int, page Page) ([]Computation, string, error)
+	// UpdateComputation and DeleteComputation work like UpdateMeasurement
+	// and DeleteMeasurement.
+	UpdateComputation(c *Computation, columns []string) error
+	DeleteComputation(id, version int) error
+}
+
+// Store is the storage used by the application. Every implementation must
+// pass the conformance tests in package storetest.
+type Store interface {
+	SatelliteStore
+	MeasurementStore
+	ComputationStore
+
+	// Ingest stores the satellites, measurements and computations of one file
+	// all or nothing. Like AddSatellites it fails with ErrInvalid if one of
+	// the satellites is soft deleted.
+	Ingest(filename string, sats map[string]satellites.Satellite) (*IngestRun, error)
+	// IngestMeasurements stores ms all or nothing, together with an ingest
+	// run of the file. Ids are not set.
+	IngestMeasurements(filename string, ms []*Measurement) (*IngestRun, error)
+}
+
+var _ Store = (*SQLDatabase)(nil)
@@ -0,0 +1,14 @@
+package database_test
+
+import (
+	"testing"
+
+	"github.com/Simek13/satelliteApp/internal/database"
+	"github.com/Simek13/satelliteApp/internal/database/storetest"
+)
+
+func TestSQLDatabaseStore(t *testing.T) {
+	storetest.Run(t, func(t *testing.T) database.Store {
+		return database.NewTestDatabase(t)
+	})
+}
@@ -0,0 +1,896 @@
+// Package storetest provides the conformance tests every database.Store
+// implementation must pass.
+package storetest
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Simek13/satelliteApp/internal/database"
+	"github.com/Simek13/satelliteApp/internal/satellites"
+	"github.com/pkg/errors"
+)
+
+// Run runs the conformance tests against stores returned by newStore. Every
+// call of newStore must return a new, empty store.
+func Run(t *testing.T, newStore func(t *testing.T) database.Store) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, s database.Store)
+	}{
+		{"Satellites", testSatellites},
+		{"UpdateSatellite", testUpdateSatellite},
+		{"SatelliteMetadata", testSatelliteMetadata},
+		{"ListSatellites", testListSatellites},
+		{"DeleteSatellite", testDeleteSatellite},
+		{"Measurements", testMeasurements},
+		{"BulkAddMeasurements", testBulkAddMeasurements},
+		{"MeasurementsBetween", testMeasurementsBetween},
+		{"ListMeasurements", testListMeasurements},
+		{"MeasurementsAfter", testMeasurementsAfter},
+		{"ListComputations", testListComputations},
+		{"Computations", testComputations},
+		{"UpdateMeasurement", testUpdateMeasurement},
+		{"UpdateComputation", testUpdateComputation},
+		{"DoubleValues", testDoubleValues},
+		{"Ingest", testIngest},
+		{"IngestMeasurements", testIngestMeasurements},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.test(t, newStore(t))
+		})
+	}
+}
+
+func sampleSatellites() map[string]satellites.Satellite {
+	sats := map[string]satellites.Satellite{
+		"30J14": &satellites.EaSatellite{
+			BasicSatellite: satellites.BasicSatellite{
+				Id:               "30J14",
+				Timestamps:       []time.Time{time.Date(2016, 02, 20, 15, 19, 0, 0, time.UTC), time.Date(2016, 02, 20, 15, 21, 0, 0, time.UTC)},
+				IonoIndexes:      []float64{5, 7},
+				NdviIndexes:      []float64{29, 33},
+				RadiationIndexes: []float64{32, 32.5},
+				SatelliteType:    satellites.Ea,
+			},
+			Altitudes: []float64{830.5, 833.5},
+		},
+		"8J14": &satellites.VcSatellite{
+			BasicSatellite: satellites.BasicSatellite{
+				Id:               "8J14",
+				Timestamps:       []time.Time{time.Date(2016, 02, 20, 15, 34, 0, 0, time.UTC)},
+				IonoIndexes:      []float64{10},
+				NdviIndexes:      []float64{49},
+				RadiationIndexes: []float64{41},
+				SatelliteType:    satellites.Vc,
+			},
+			Vegetations: []string{"WOODS"},
+		},
+	}
+	for _, sat := range sats {
+		sat.MeasurementTime()
+		sat.Compute()
+	}
+	return sats
+}
+
+func addSatellite(t *testing.T, s database.Store, name string) int {
+	t.Helper()
+	sat := &database.Satellite{Name: name}
+	if err := s.AddSatellite(sat); err != nil {
+		t.Fatalf("AddSatellite(%q) error = %v", name, err)
+	}
+	return sat.Id
+}
+
+func testSatellites(t *testing.T, s database.Store) {
+	id1 := addSatellite(t, s, "30J14")
+	id2 := addSatellite(t, s, "8J14")
+	if id1 <= 0 || id2 <= 0 || id1 == id2 {
+		t.Errorf("AddSatellite() ids = %d, %d, want distinct positive ids", id1, id2)
+	}
+
+	if err := s.AddSatellite(&database.Satellite{Name: "30J14"}); !errors.Is(err, database.ErrDuplicate) {
+		t.Errorf("AddSatellite() duplicate error = %v, want %v", err, database.ErrDuplicate)
+	}
+
+	tests := []struct {
+		name string
+		want int
+	}{
+		{"30J14", id1},
+		{"8J14", id2},
+		{"6N14", 0},
+	}
+	for _, tt := range tests {
+		if got, err := s.GetSatelliteId(tt.name); err != nil || got != tt.want {
+			t.Errorf("GetSatelliteId(%q) = %d, %v, want %d", tt.name, got, err, tt.want)
+		}
+	}
+
+	if got, err := s.GetSatellite(id2); err != nil || got.Id != id2 || got.Name != "8J14" {
+		t.Errorf("GetSatellite(%d) = %v, %v, want 8J14", id2, got, err)
+	}
+	if _, err := s.GetSatellite(id2 + 100); !errors.Is(err, database.ErrNotFound) {
+		t.Errorf("GetSatellite() unknown error = %v, want %v", err, database.ErrNotFound)
+	}
+
+	// existing satellites are skipped
+	if err := s.AddSatellites(sampleSatellites()); err != nil {
+		t.Fatalf("AddSatellites() error = %v", err)
+	}
+	if got, _ := s.GetSatelliteId("30J14"); got != id1 {
+		t.Errorf("GetSatelliteId() after AddSatellites() = %d, want %d", got, id1)
+	}
+}
+
+func testUpdateSatellite(t *testing.T, s database.Store) {
+	id := addSatellite(t, s, "30J14")
+	addSatellite(t, s, "8J14")
+
+	name := []string{"name"}
+	if err := s.UpdateSatellite(&database.Satellite{Id: id, Name: "30J15"}, name); err != nil {
+		t.Fatalf("UpdateSatellite() error = %v", err)
+	}
+	if got, err := s.GetSatelliteByName("30J15"); err != nil || got.Id != id {
+		t.Errorf("GetSatelliteByName() = %v, %v, want satellite %d", got, err, id)
+	}
+	if _, err := s.GetSatelliteByName("30J14"); !errors.Is(err, database.ErrNotFound) {
+		t.Errorf("GetSatelliteByName() old name error = %v, want %v", err, database.ErrNotFound)
+	}
+	// renaming to the same name is not a duplicate
+	if err := s.UpdateSatellite(&database.Satellite{Id: id, Name: "30J15"}, name); err != nil {
+		t.Errorf("UpdateSatellite() same name error = %v", err)
+	}
+
+	if err := s.UpdateSatellite(&database.Satellite{Id: id, Name: "8J14"}, name); !errors.Is(err, database.ErrDuplicate) {
+		t.Errorf("UpdateSatellite() duplicate error = %v, want %v", err, database.ErrDuplicate)
+	}
+	if err := s.UpdateSatellite(&database.Satellite{Id: id + 100, Name: "6N14"}, name); !errors.Is(err, database.ErrNotFound) {
+		t.Errorf("UpdateSatellite() unknown error = %v, want %v", err, database.ErrNotFound)
+	}
+
+	launch := time.Date(2014, 3, 2, 0, 0, 0, 0, time.UTC)
+	sat := &database.Satellite{Id: id, Mission: "Sentinel", Status: database.SatelliteRetired, Tags: []string{"ndvi", "iono"}}
+	if err := s.UpdateSatellite(sat, []string{"mission", "status", "tags"}); err != nil {
+		t.Fatalf("UpdateSatellite() metadata error = %v", err)
+	}
+	want := database.Satellite{Id: id, Name: "30J15", Mission: "Sentinel", Status: database.SatelliteRetired, Tags: []string{"iono", "ndvi"}}
+	if !reflect.DeepEqual(*sat, want) {
+		t.Errorf("UpdateSatellite() = %v, want %v", *sat, want)
+	}
+
+	// columns not named are kept, tags are replaced
+	sat = &database.Satellite{Id: id, LaunchDate: &launch, Tags: []string{"radiation"}}
+	if err := s.UpdateSatellite(sat, []string{"launchDate", "tags"}); err != nil {
+		t.Fatalf("UpdateSatellite() launch date error = %v", err)
+	}
+	got, err := s.GetSatellite(id)
+	if err != nil {
+		t.Fatalf("GetSatellite() error = %v", err)
+	}
+	want.LaunchDate = &launch
+	want.Tags = []string{"radiation"}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("GetSatellite() after update = %v, want %v", *got, want)
+	}
+}
+
+func testSatelliteMetadata(t *testing.T, s database.Store) {
+	// the launch time is truncated to its UTC day
+	launch := time.Date(2014, 3, 2, 23, 30, 0, 0, time.FixedZone("", -2*60*60))
+	sat := &database.Satellite{
+		Name:        "30J14",
+		Type:        satellites.Ea.String(),
+		Description: "Earth observation",
+		Mission:     "Sentinel",
+		Operator:    "ESA",
+		LaunchDate:  &launch,
+		Tags:        []string{"ndvi", "iono", "ndvi"},
+	}
+	if err := s.AddSatellite(sat); err != nil {
+		t.Fatalf("AddSatellite() error = %v", err)
+	}
+	day := time.Date(2014, 3, 3, 0, 0, 0, 0, time.UTC)
+	want := database.Satellite{
+		Id:          sat.Id,
+		Name:        "30J14",
+		Type:        "Ea",
+		Description: "Earth observation",
+		Mission:     "Sentinel",
+		Operator:    "ESA",
+		LaunchDate:  &day,
+		Status:      database.SatelliteActive,
+		Tags:        []string{"iono", "ndvi"},
+	}
+	got, err := s.GetSatellite(sat.Id)
+	if err != nil {
+		t.Fatalf("GetSatellite() error = %v", err)
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("GetSatellite() = %v, want %v", *got, want)
+	}
+
+	// satellites added from files get their type
+	if err := s.AddSatellites(sampleSatellites()); err != nil {
+		t.Fatalf("AddSatellites() error = %v", err)
+	}
+	if got, err := s.GetSatelliteByName("8J14"); err != nil || got.Type != "Vc" || got.Status != database.SatelliteActive {
+		t.Errorf("GetSatelliteByName() = %v, %v, want an active Vc satellite", got, err)
+	}
+}
+
+func testListSatellites(t *testing.T, s database.Store) {
+	ids := []int{addSatellite(t, s, "30J14"), addSatellite(t, s, "8J14"), addSatellite(t, s, "6N14")}
+	if err := s.SoftDeleteSatellite(ids[1]); err != nil {
+		t.Fatalf("SoftDeleteSatellite() error = %v", err)
+	}
+	launches := []time.Time{
+		time.Date(2014, 3, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2015, 6, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2016, 1, 10, 0, 0, 0, 0, time.UTC),
+	}
+	metadata := []database.Satellite{
+		{Type: "Ea", Mission: "Sentinel", Operator: "ESA", Status: database.SatelliteActive, Tags: []string{"iono", "ndvi"}},
+		{Type: "Vc", Mission: "Sentinel", Operator: "ESA", Status: database.SatelliteRetired, Tags: []string{"ndvi"}},
+		{Type: "Ea", Mission: "Landsat", Operator: "NASA", Status: database.SatelliteRetired},
+	}
+	for i, sat := range metadata {
+		sat.Id = ids[i]
+		sat.LaunchDate = &launches[i]
+		if err := s.UpdateSatellite(&sat, []string{"satType", "mission", "operator", "launchDate", "status", "tags"}); err != nil {
+			t.Fatalf("UpdateSatellite() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name string
+		q    database.SatelliteQuery
+		size int
+		desc bool
+		want []int
+	}{
+		{"deleted hidden", database.SatelliteQuery{}, 0, false, []int{ids[0], ids[2]}},
+		{"show deleted", database.SatelliteQuery{ShowDeleted: true}, 0, false, ids},
+		{"pages", database.SatelliteQuery{ShowDeleted: true}, 2, false, ids},
+		{"descending pages", database.SatelliteQuery{ShowDeleted: true}, 2, true, []int{ids[2], ids[1], ids[0]}},
+		{"type", database.SatelliteQuery{ShowDeleted: true, Type: "Ea"}, 0, false, []int{ids[0], ids[2]}},
+		{"mission", database.SatelliteQuery{ShowDeleted: true, Mission: "Sentinel"}, 0, false, []int{ids[0], ids[1]}},
+		{"operator", database.SatelliteQuery{Operator: "ESA"}, 0, false, []int{ids[0]}},
+		{"status", database.SatelliteQuery{ShowDeleted: true, Status: database.SatelliteRetired}, 1, false, []int{ids[1], ids[2]}},
+		{"tag", database.SatelliteQuery{ShowDeleted: true, Tag: "ndvi"}, 1, true, []int{ids[1], ids[0]}},
+		{"launched after", database.SatelliteQuery{ShowDeleted: true, LaunchedAfter: launches[1]}, 0, false, []int{ids[1], ids[2]}},
+		{"launch range", database.SatelliteQuery{ShowDeleted: true, LaunchedAfter: launches[0], LaunchedBefore: launches[2]}, 0, false, []int{ids[0], ids[1]}},
+		{"no match", database.SatelliteQuery{Tag: "radiation"}, 0, false, []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make([]int, 0)
+			page := database.Page{Size: tt.size, Descending: tt.desc}
+			for {
+				sats, next, err := s.ListSatellites(tt.q, page)
+				if err != nil {
+					t.Fatalf("ListSatellites() error = %v", err)
+				}
+				for _, sat := range sats {
+					got = append(got, sat.Id)
+				}
+				if next == "" {
+					break
+				}
+				page.Token = next
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListSatellites() ids = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func testDeleteSatellite(t *testing.T, s database.Store) {
+	id := addSatellite(t, s, "30J14")
+	empty := addSatellite(t, s, "8J14")
+	if err := s.AddMeasurement(&database.Measurement{FileName: "a.csv", IdSat: id}); err != nil {
+		t.Fatalf("AddMeasurement() error = %v", err)
+	}
+	if err := s.AddComputation(&database.Computation{IdSat: id}); err != nil {
+		t.Fatalf("AddComputation() error = %v", err)
+	}
+
+	// soft deleted satellites are still returned and keep their data
+	if err := s.SoftDeleteSatellite(id); err != nil {
+		t.Fatalf("SoftDeleteSatellite() error = %v", err)
+	}
+	sat, err := s.GetSatellite(id)
+	if err != nil || sat.Deleted == nil {
+		t.Fatalf("GetSatellite() after soft delete = %v, %v, want deleted time", sat, err)
+	}
+	deleted := *sat.Deleted
+	// deleting again is idempotent
+	if err := s.SoftDeleteSatellite(id); err != nil {
+		t.Fatalf("SoftDeleteSatellite() again error = %v", err)
+	}
+	if sat, err := s.GetSatellite(id); err != nil || !sat.Deleted.Equal(deleted) {
+		t.Errorf("GetSatellite() after second soft delete = %v, %v, want deleted at %v", sat, err, deleted)
+	}
+
+	if err := s.DeleteSatellite(id, false); !errors.Is(err, database.ErrForeignKey) {
+		t.Errorf("DeleteSatellite() with data error = %v, want %v", err, database.ErrForeignKey)
+	}
+	if ms, err := s.GetMeasurements(id); err != nil || len(ms) != 1 {
+		t.Errorf("GetMeasurements() after blocked delete = %d measurements, %v, want 1", len(ms), err)
+	}
+
+	if err := s.DeleteSatellite(id, true); err != nil {
+		t.Fatalf("DeleteSatellite() cascade error = %v", err)
+	}
+	if err := s.DeleteSatellite(empty, false); err != nil {
+		t.Fatalf("DeleteSatellite() error = %v", err)
+	}
+	for _, id := range []int{id, empty} {
+		if _, err := s.GetSatellite(id); !errors.Is(err, database.ErrNotFound) {
+			t.Errorf("GetSatellite(%d) after delete error = %v, want %v", id, err, database.ErrNotFound)
+		}
+	}
+	if ms, err := s.GetMeasurements(0); err != nil || len(ms) != 0 {
+		t.Errorf("GetMeasurements() after cascade = %d measurements, %v, want none", len(ms), err)
+	}
+	if cs, err := s.GetComputations(0); err != nil || len(cs) != 0 {
+		t.Errorf("GetComputations() after cascade = %d computations, %v, want none", len(cs), err)
+	}
+
+	if err := s.DeleteSatellite(id, true); !errors.Is(err, database.ErrNotFound) {
+		t.Errorf("DeleteSatellite() unknown error = %v, want %v", err, database.ErrNotFound)
+	}
+	if err := s.SoftDeleteSatellite(id); !errors.Is(err, database.ErrNotFound) {
+		t.Errorf("SoftDeleteSatellite() unknown error = %v, want %v", err, database.ErrNotFound)
+	}
+}
+
+func testMeasurements(t *testing.T, s database.Store) {
+	id1 := addSatellite(t, s, "30J14")
+	id2 := addSatellite(t, s, "8J14")
+
+	ms := []*database.Measurement{
+		{FileName: "a.csv", IdSat: id1, IonoIndex: 1},
+		{FileName: "a.csv", IdSat: id2, IonoIndex: 2},
+		{FileName: "a.csv", IdSat: id1, IonoIndex: 3},
+	}
+	for _, m := range ms {
+		if err := s.AddMeasurement(m); err != nil {
+			t.Fatalf("AddMeasurement() error = %v", err)
+		}
+		if m.Id <= 0 {
+			t.Errorf("AddMeasurement() id = %d, want positive id", m.Id)
+		}
+	}
+
+	err := s.AddMeasurement(&database.Measurement{FileName: "a.csv", IdSat: id2 + 100})
+	if !errors.Is(err, database.ErrForeignKey) {
+		t.Errorf("AddMeasurement() unknown satellite error = %v, want %v", err, database.ErrForeignKey)
+	}
+
+	tests := []struct {
+		name  string
+		satId int
+		want  []float64
+	}{
+		{"all", 0, []float64{1, 2, 3}},
+		{"one satellite", id1, []float64{1, 3}},
+		{"unknown satellite", id2 + 100, []float64{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.GetMeasurements(tt.satId)
+			if err != nil {
+				t.Fatalf("GetMeasurements() error = %v", err)
+			}
+			if got == nil {
+				t.Errorf("GetMeasurements() = nil, want empty slice")
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("GetMeasurements() = %d measurements, want %d", len(got), len(tt.want))
+			}
+			for i, m := range got {
+				if m.IonoIndex != tt.want[i] || (i > 0 && m.Id <= got[i-1].Id) {
+					t.Errorf("GetMeasurements()[%d] = %v, want iono %v in id order", i, m, tt.want[i])
+				}
+			}
+		})
+	}
+
+	// measurements of satellites that are not stored are rejected
+	if err := s.AddMeasurements("b.csv", map[string]satellites.Satellite{"6N14": sampleSatellites()["8J14"]}); err == nil {
+		t.Errorf("AddMeasurements() unknown satellite error = nil, want error")
+	}
+	if err := s.AddMeasurements("b.csv", sampleSatellites()); err != nil {
+		t.Fatalf("AddMeasurements() error = %v", err)
+	}
+	if got, _ := s.GetMeasurements(id1); len(got) != 4 || got[3].FileName != "b.csv" {
+		t.Errorf("GetMeasurements() after AddMeasurements() = %v, want 4 measurements", got)
+	}
+}
+
+func testBulkAddMeasurements(t *testing.T, s database.Store) {
+	idSat := addSatellite(t, s, "30J14")
+
+	ms := database.MeasurementsOf("a.csv", idSat, sampleSatellites()["30J14"])
+	if err := s.BulkAddMeasurements(ms); err != nil {
+		t.Fatalf("BulkAddMeasurements() error = %v", err)
+	}
+
+	// a single bad row rejects the whole batch
+	bad := append(database.MeasurementsOf("b.csv", idSat, sampleSatellites()["30J14"]), &database.Measurement{FileName: "b.csv", IdSat: idSat + 100})
+	if err := s.BulkAddMeasurements(bad); !errors.Is(err, database.ErrForeignKey) {
+		t.Errorf("BulkAddMeasurements() error = %v, want %v", err, database.ErrForeignKey)
+	}
+
+	got, err := s.GetMeasurements(0)
+	if err != nil || len(got) != len(ms) {
+		t.Fatalf("GetMeasurements() = %d measurements, %v, want %d", len(got), err, len(ms))
+	}
+	for i, m := range got {
+		want := *ms[i]
+		want.Id, want.Version = m.Id, 1
+		if m != want {
+			t.Errorf("GetMeasurements()[%d] = %v, want %v", i, m, want)
+		}
+	}
+}
+
+func testMeasurementsBetween(t *testing.T, s database.Store) {
+	id1 := addSatellite(t, s, "30J14")
+	id2 := addSatellite(t, s, "8J14")
+
+	at := func(minute int) time.Time {
+		return time.Date(2016, 02, 20, 15, minute, 0, 0, time.UTC)
+	}
+	ms := []*database.Measurement{
+		{FileName: "a.csv", IdSat: id1, Timestamp: at(30), IonoIndex: 30},
+		{FileName: "a.csv", IdSat: id1, Timestamp: at(10), IonoIndex: 10},
+		{FileName: "a.csv", IdSat: id2, Timestamp: at(20), IonoIndex: 20},
+		{FileName: "a.csv", IdSat: id1, Timestamp: at(20), IonoIndex: 21},
+		{FileName: "a.csv", IdSat: id1, Timestamp: at(40).In(time.FixedZone("CEST", 2*60*60)), IonoIndex: 40},
+	}
+	for _, m := range ms {
+		if err := s.AddMeasurement(m); err != nil {
+			t.Fatalf("AddMeasurement() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name       string
+		satId      int
+		start, end time.Time
+		want       []float64
+	}{
+		{"unbounded", id1, time.Time{}, time.Time{}, []float64{10, 21, 30, 40}},
+		{"start is inclusive", id1, at(20), time.Time{}, []float64{21, 30, 40}},
+		{"end is exclusive", id1, time.Time{}, at(30), []float64{10, 21}},
+		{"range", id1, at(15), at(35), []float64{21, 30}},
+		{"other time zone", id1, at(40).In(time.FixedZone("EST", -5*60*60)), time.Time{}, []float64{40}},
+		{"every satellite", 0, at(20), at(21), []float64{20, 21}},
+		{"empty range", id1, at(41), at(50), []float64{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.GetMeasurementsBetween(tt.satId, tt.start, tt.end)
+			if err != nil {
+				t.Fatalf("GetMeasurementsBetween() error = %v", err)
+			}
+			if got == nil {
+				t.Errorf("GetMeasurementsBetween() = nil, want empty slice")
+			}
+			values := make([]float64, 0, len(got))
+			for _, m := range got {
+				values = append(values, m.IonoIndex)
+			}
+			if !reflect.DeepEqual(values, tt.want) {
+				t.Errorf("GetMeasurementsBetween() = %v, want %v", values, tt.want)
+			}
+		})
+	}
+}
+
+func testListMeasurements(t *testing.T, s database.Store) {
+	id1 := addSatellite(t, s, "30J14")
+	id2 := addSatellite(t, s, "8J14")
+
+	// equal timestamps are ordered by id
+	at := func(minute int) time.Time {
+		return time.Date(2016, 02, 20, 15, minute, 0, 0, time.UTC)
+	}
+	for i, minute := range []int{30, 10, 20, 20, 40, 20} {
+		idSat := id1
+		if i == 5 {
+			idSat = id2
+		}
+		m := &database.Measurement{FileName: "a.csv", IdSat: idSat, Timestamp: at(minute), IonoIndex: float64(i)}
+		if err := s.AddMeasurement(m); err != nil {
+			t.Fatalf("AddMeasurement() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name string
+		q    database.MeasurementQuery
+		size int
+		desc bool
+		want [][]float64
+	}{
+		{"single page", database.MeasurementQuery{SatId: id1}, 0, false, [][]float64{{1, 2, 3, 0, 4}}},
+		{"ascending", database.MeasurementQuery{SatId: id1}, 2, false, [][]float64{{1, 2}, {3, 0}, {4}}},
+		{"descending", database.MeasurementQuery{SatId: id1}, 2, true, [][]float64{{4, 0}, {3, 2}, {1}}},
+		{"exact pages", database.MeasurementQuery{}, 3, false, [][]float64{{1, 2, 3}, {5, 0, 4}}},
+		{"time range", database.MeasurementQuery{SatId: id1, Start: at(20), End: at(40)}, 2, true, [][]float64{{0, 3}, {2}}},
+		{"empty", database.MeasurementQuery{SatId: id2 + 100}, 2, false, [][]float64{{}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := database.Page{Size: tt.size, Descending: tt.desc}
+			got := make([][]float64, 0)
+			for {
+				measurements, next, err := s.ListMeasurements(tt.q, page)
+				if err != nil {
+					t.Fatalf("ListMeasurements() error = %v", err)
+				}
+				values := make([]float64, 0, len(measurements))
+				for _, m := range measurements {
+					values = append(values, m.IonoIndex)
+				}
+				got = append(got, values)
+				if next == "" || len(got) > len(tt.want) {
+					break
+				}
+				page.Token = next
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListMeasurements() pages = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	_, next, err := s.ListMeasurements(database.MeasurementQuery{}, database.Page{Size: 1})
+	if err != nil || next == "" {
+		t.Fatalf("ListMeasurements() = %q, %v, want next page token", next, err)
+	}
+	for _, page := range []database.Page{{Size: 1, Token: "garbage"}, {Size: 1, Token: next, Descending: true}} {
+		if _, _, err := s.ListMeasurements(database.MeasurementQuery{}, page); !errors.Is(err, database.ErrInvalidPageToken) {
+			t.Errorf("ListMeasurements(%+v) error = %v, want %v", page, err, database.ErrInvalidPageToken)
+		}
+	}
+}
+
+func testListComputations(t *testing.T, s database.Store) {
+	ids := make([]int, 0)
+	for _, name := range []string{"30J14", "8J14", "6N14"} {
+		idSat := addSatellite(t, s, name)
+		c := &database.Computation{IdSat: idSat}
+		if err := s.AddComputation(c); err != nil {
+			t.Fatalf("AddComputation() error = %v", err)
+		}
+		ids = append(ids, c.Id)
+	}
+
+	tests := []struct {
+		name string
+		desc bool
+		want [][]int
+	}{
+		{"ascending", false, [][]int{{ids[0], ids[1]}, {ids[2]}}},
+		{"descending", true, [][]int{{ids[2], ids[1]}, {ids[0]}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := database.Page{Size: 2, Descending: tt.desc}
+			got := make([][]int, 0)
+			for {
+				computations, next, err := s.ListComputations(0, page)
+				if err != nil {
+					t.Fatalf("ListComputations() error = %v", err)
+				}
+				pageIds := make([]int, 0, len(computations))
+				for _, c := range computations {
+					pageIds = append(pageIds, c.Id)
+				}
+				got = append(got, pageIds)
+				if next == "" || len(got) > len(tt.want) {
+					break
+				}
+				page.Token = next
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListComputations() pages = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func testComputations(t *testing.T, s database.Store) {
+	idSat := addSatellite(t, s, "30J14")
+
+	c := database.ComputationOf(idSat, sampleSatellites()["30J14"])
+	if err := s.AddComputation(c); err != nil {
+		t.Fatalf("AddComputation() error = %v", err)
+	}
+	if c.Id <= 0 {
+		t.Errorf("AddComputation() id = %d, want positive id", c.Id)
+	}
+
+	tests := []struct {
+		name string
+		c    *database.Computation
+		want error
+	}{
+		{"duplicate", &database.Computation{IdSat: idSat}, database.ErrDuplicate},
+		{"unknown satellite", &database.Computation{IdSat: idSat + 100}, database.ErrForeignKey},
+	}
+	for _, tt := range tests {
+		if err := s.AddComputation(tt.c); !errors.Is(err, tt.want) {
+			t.Errorf("AddComputation() %s error = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	got, err := s.GetComputations(idSat)
+	if err != nil || len(got) != 1 || got[0] != *c {
+		t.Fatalf("GetComputations() = %v, %v, want [%v]", got, err, *c)
+	}
+
+	// existing computations are skipped
+	addSatellite(t, s, "8J14")
+	if err := s.AddComputations(sampleSatellites()); err != nil {
+		t.Fatalf("AddComputations() error = %v", err)
+	}
+	if got, err := s.GetComputations(0); err != nil || len(got) != 2 || got[0] != *c {
+		t.Errorf("GetComputations() = %v, %v, want 2 computations", got, err)
+	}
+	if got, err := s.GetComputations(idSat + 100); err != nil || got == nil || len(got) != 0 {
+		t.Errorf("GetComputations() unknown satellite = %v, %v, want empty slice", got, err)
+	}
+}
+
+// testDoubleValues checks that values are stored in double precision.
+func testDoubleValues(t *testing.T, s database.Store) {
+	idSat := addSatellite(t, s, "30J14")
+	a, b := 0.1, 0.2
+	v := a + b // 0.30000000000000004, float32 rounds it to 0.3
+
+	m := &database.Measurement{FileName: "a.csv", IdSat: idSat, Timestamp: time.Date(2016, 02, 20, 15, 19, 0, 0, time.UTC),
+		IonoIndex: v, NdviIndex: v, RadiationIndex: v}
+	if err := s.AddMeasurement(m); err != nil {
+		t.Fatalf("AddMeasurement() error = %v", err)
+	}
+	if got, err := s.GetMeasurements(idSat); err != nil || len(got) != 1 || got[0] != *m {
+		t.Errorf("GetMeasurements() = %v, %v, want [%v]", got, err, *m)
+	}
+
+	c := &database.Computation{IdSat: idSat, MaxIono: v, MinIono: v, AvgIono: v, MaxNdvi: v, MinNdvi: v, AvgNdvi: v,
+		MaxRad: v, MinRad: v, AvgRad: v, MaxSpec: v, MinSpec: v, AvgSpec: v}
+	if err := s.AddComputation(c); err != nil {
+		t.Fatalf("AddComputation() error = %v", err)
+	}
+	if got, err := s.GetComputations(idSat); err != nil || len(got) != 1 || got[0] != *c {
+		t.Errorf("GetComputations() = %v, %v, want [%v]", got, err, *c)
+	}
+}
+
+func testIngest(t *testing.T, s database.Store) {
+	sats := sampleSatellites()
+
+	run, err := s.Ingest("happypath.csv", sats)
+	if err != nil {
+		t.Fatalf("Ingest() error = %v", err)
+	}
+	if run.Id <= 0 || run.FileName != "happypath.csv" || run.Satellites != 2 || run.Measurements != 3 || run.Computations != 2 {
+		t.Errorf("Ingest() = %+v, want 2 satellites, 3 measurements and 2 computations", run)
+	}
+
+	idSat, err := s.GetSatelliteId("30J14")
+	if err != nil || idSat == 0 {
+		t.Fatalf("GetSatelliteId() = %v, %v", idSat, err)
+	}
+	computations, err := s.GetComputations(idSat)
+	if err != nil || len(computations) != 1 {
+		t.Fatalf("GetComputations() = %v, %v", computations, err)
+	}
+	c := computations[0]
+	if c.MinIono != 5 || c.MaxIono != 7 || c.AvgIono != 6 || c.MinSpec != 830.5 || c.MaxSpec != 833.5 || c.Duration != 2*time.Minute {
+		t.Errorf("GetComputations() = %v", c)
+	}
+
+	// satellites and computations are only stored once
+	run, err = s.Ingest("happypath.csv", sats)
+	if err != nil {
+		t.Fatalf("Ingest() error = %v", err)
+	}
+	if run.Satellites != 0 || run.Measurements != 3 || run.Computations != 0 {
+		t.Errorf("Ingest() = %+v, want 0 satellites, 3 measurements and 0 computations", run)
+	}
+	if measurements, err := s.GetMeasurements(0); err != nil || len(measurements) != 6 {
+		t.Errorf("GetMeasurements() = %d measurements, %v, want 6", len(measurements), err)
+	}
+
+	// files with soft deleted satellites are rejected
+	if err := s.SoftDeleteSatellite(idSat); err != nil {
+		t.Fatalf("SoftDeleteSatellite() error = %v", err)
+	}
+	if _, err := s.Ingest("happypath.csv", sats); !errors.Is(err, database.ErrInvalid) {
+		t.Errorf("Ingest() deleted satellite error = %v, want %v", err, database.ErrInvalid)
+	}
+	if err := s.AddSatellites(sats); !errors.Is(err, database.ErrInvalid) {
+		t.Errorf("AddSatellites() deleted satellite error = %v, want %v", err, database.ErrInvalid)
+	}
+	if measurements, err := s.GetMeasurements(0); err != nil || len(measurements) != 6 {
+		t.Errorf("GetMeasurements() after rejected ingest = %d measurements, %v, want 6", len(measurements), err)
+	}
+}
+
+func testIngestMeasurements(t *testing.T, s database.Store) {
+	idSat := addSatellite(t, s, "30J14")
+
+	ms := database.MeasurementsOf("upload.csv", idSat, sampleSatellites()["30J14"])
+	run, err := s.IngestMeasurements("upload.csv", ms)
+	if err != nil {
+		t.Fatalf("IngestMeasurements() error = %v", err)
+	}
+	if run.Id <= 0 || run.FileName != "upload.csv" || run.Satellites != 0 || run.Measurements != len(ms) || run.Computations != 0 {
+		t.Errorf("IngestMeasurements() = %+v, want %d measurements", run, len(ms))
+	}
+
+	// a single bad row rejects the whole upload
+	bad := append(database.MeasurementsOf("bad.csv", idSat, sampleSatellites()["30J14"]), &database.Measurement{FileName: "bad.csv", IdSat: idSat + 100})
+	if _, err := s.IngestMeasurements("bad.csv", bad); !errors.Is(err, database.ErrForeignKey) {
+		t.Errorf("IngestMeasurements() error = %v, want %v", err, database.ErrForeignKey)
+	}
+	if measurements, err := s.GetMeasurements(0); err != nil || len(measurements) != len(ms) {
+		t.Errorf("GetMeasurements() = %d measurements, %v, want %d", len(measurements), err, len(ms))
+	}
+}
+
+func testMeasurementsAfter(t *testing.T, s database.Store) {
+	if last, err := s.LastMeasurementId(); err != nil || last != 0 {
+		t.Errorf("LastMeasurementId() of an empty store = %d, %v, want 0", last, err)
+	}
+
+	idSat := addSatellite(t, s, "30J14")
+	ids := make([]int, 0, 3)
+	for i := 0; i < 3; i++ {
+		m := &database.Measurement{FileName: "a.csv", IdSat: idSat, Timestamp: time.Date(2016, 02, 20, 15, 30-i, 0, 0, time.UTC)}
+		if err := s.AddMeasurement(m); err != nil {
+			t.Fatalf("AddMeasurement() error = %v", err)
+		}
+		ids = append(ids, m.Id)
+	}
+	if last, err := s.LastMeasurementId(); err != nil || last != ids[2] {
+		t.Errorf("LastMeasurementId() = %d, %v, want %d", last, err, ids[2])
+	}
+
+	tests := []struct {
+		name  string
+		id    int
+		limit int
+		want  []int
+	}{
+		{"all", 0, 10, ids},
+		{"after", ids[0], 10, ids[1:]},
+		{"limit", 0, 2, ids[:2]},
+		{"none", ids[2], 10, []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			measurements, err := s.MeasurementsAfter(tt.id, tt.limit)
+			if err != nil {
+				t.Fatalf("MeasurementsAfter() error = %v", err)
+			}
+			got := make([]int, 0, len(measurements))
+			for _, m := range measurements {
+				got = append(got, m.Id)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MeasurementsAfter(%d, %d) ids = %v, want %v", tt.id, tt.limit, got, tt.want)
+			}
+		})
+	}
+}
+
+func testUpdateMeasurement(t *testing.T, s database.Store) {
+	id1 := addSatellite(t, s, "30J14")
+	id2 := addSatellite(t, s, "8J14")
+	m := &database.Measurement{FileName: "a.csv", IdSat: id1, Timestamp: time.Date(2016, 02, 20, 15, 19, 0, 0, time.UTC), IonoIndex: 5, NdviIndex: 29}
+	if err := s.AddMeasurement(m); err != nil {
+		t.Fatalf("AddMeasurement() error = %v", err)
+	}
+	if m.Version != 1 {
+		t.Errorf("AddMeasurement() version = %d, want 1", m.Version)
+	}
+
+	columns, err := database.MeasurementColumns([]string{"sat_id", "iono_index"})
+	if err != nil {
+		t.Fatalf("MeasurementColumns() error = %v", err)
+	}
+	update := &database.Measurement{Id: m.Id, IdSat: id2, IonoIndex: 6, NdviIndex: 99, Version: 1}
+	if err := s.UpdateMeasurement(update, columns); err != nil {
+		t.Fatalf("UpdateMeasurement() error = %v", err)
+	}
+	want := *m
+	want.IdSat, want.IonoIndex, want.Version = id2, 6, 2
+	if *update != want {
+		t.Errorf("UpdateMeasurement() = %v, want %v", *update, want)
+	}
+	if got, err := s.GetMeasurements(id2); err != nil || len(got) != 1 || got[0] != want {
+		t.Errorf("GetMeasurements() after update = %v, %v, want %v", got, err, want)
+	}
+
+	tests := []struct {
+		name    string
+		m       database.Measurement
+		columns []string
+		want    error
+	}{
+		{"stale version", database.Measurement{Id: m.Id, IonoIndex: 7, Version: 1}, []string{"ionoIndex"}, database.ErrVersionConflict},
+		{"unknown satellite", database.Measurement{Id: m.Id, IdSat: id2 + 100}, []string{"idSat"}, database.ErrForeignKey},
+		{"unknown id", database.Measurement{Id: m.Id + 100, IonoIndex: 7}, []string{"ionoIndex"}, database.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := s.UpdateMeasurement(&tt.m, tt.columns); !errors.Is(err, tt.want) {
+				t.Errorf("UpdateMeasurement() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	if err := s.DeleteMeasurement(m.Id, 1); !errors.Is(err, database.ErrVersionConflict) {
+		t.Errorf("DeleteMeasurement() stale version error = %v, want %v", err, database.ErrVersionConflict)
+	}
+	if err := s.DeleteMeasurement(m.Id, 2); err != nil {
+		t.Fatalf("DeleteMeasurement() error = %v", err)
+	}
+	if err := s.DeleteMeasurement(m.Id, 0); !errors.Is(err, database.ErrNotFound) {
+		t.Errorf("DeleteMeasurement() deleted error = %v, want %v", err, database.ErrNotFound)
+	}
+	if got, err := s.GetMeasurements(0); err != nil || len(got) != 0 {
+		t.Errorf("GetMeasurements() after delete = %v, %v, want none", got, err)
+	}
+}
+
+func testUpdateComputation(t *testing.T, s database.Store) {
+	id1 := addSatellite(t, s, "30J14")
+	id2 := addSatellite(t, s, "8J14")
+	c1 := &database.Computation{IdSat: id1, Duration: time.Minute, MaxIono: 7}
+	c2 := &database.Computation{IdSat: id2}
+	for _, c := range []*database.Computation{c1, c2} {
+		if err := s.AddComputation(c); err != nil {
+			t.Fatalf("AddComputation() error = %v", err)
+		}
+	}
+
+	// every column is written without a version check
+	update := &database.Computation{Id: c1.Id, IdSat: id1, Duration: time.Hour, MinIono: 1}
+	columns, err := database.ComputationColumns(nil)
+	if err != nil {
+		t.Fatalf("ComputationColumns() error = %v", err)
+	}
+	if err := s.UpdateComputation(update, columns); err != nil {
+		t.Fatalf("UpdateComputation() error = %v", err)
+	}
+	if want := (database.Computation{Id: c1.Id, IdSat: id1, Duration: time.Hour, MinIono: 1, Version: 2}); *update != want {
+		t.Errorf("UpdateComputation() = %v, want %v", *update, want)
+	}
+
+	if err := s.UpdateComputation(&database.Computation{Id: c1.Id, IdSat: id2}, []string{"idSat"}); !errors.Is(err, database.ErrDuplicate) {
+		t.Errorf("UpdateComputation() second computation of satellite error = %v, want %v", err, database.ErrDuplicate)
+	}
+	if _, err := database.ComputationColumns([]string{"id"}); err == nil {
+		t.Errorf("ComputationColumns(id) error = nil, want error")
+	}
+
+	if err := s.DeleteComputation(c1.Id, 2); err != nil {
+		t.Fatalf("DeleteComputation() error = %v", err)
+	}
+	if got, err := s.GetComputations(0); err != nil || len(got) != 1 || got[0].Id != c2.Id {
+		t.Errorf("GetComputations() after delete = %v, %v, want computation %d", got, err, c2.Id)
+	}
+}
@@ -0,0 +1,119 @@
+// Package migrate runs the embedded schema migrations from the binaries.
+package migrate
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/Simek13/satelliteApp/assets"
+	"github.com/Simek13/satelliteApp/internal/database"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Commands describes the arguments accepted by Run.
+const Commands = "up | down [N] | status | version | force V"
+
+// New returns a migrator of d for the migrations embedded in the binary.
+func New(d *database.SQLDatabase) (*database.Migrator, error) {
+	fsys, err := assets.Migrations(d.Dialect())
+	if err != nil {
+		return nil, err
+	}
+	return database.NewMigrator(d, fsys)
+}
+
+// Run runs the migrate command given by args against the database described
+// by c and writes its output to w. "up" creates the database if needed,
+// "down" reverts the last N migrations, one by default.
+func Run(c database.Config, args []string, w io.Writer) error {
+	if len(args) == 0 {
+		return errors.Errorf("missing migrate command, want %s", Commands)
+	}
+	cmd, args := args[0], args[1:]
+
+	n := 1
+	switch {
+	case cmd != "up" && cmd != "down" && cmd != "status" && cmd != "version" && cmd != "force":
+		return errors.Errorf("unknown migrate command %q, want %s", cmd, Commands)
+	case cmd == "force" && len(args) == 0:
+		return errors.New("force needs the version to set")
+	case len(args) > 1 || len(args) == 1 && cmd != "down" && cmd != "force":
+		return errors.Errorf("too many arguments for %s", cmd)
+	case len(args) == 1:
+		var err error
+		if n, err = strconv.Atoi(args[0]); err != nil || n < 0 {
+			return errors.Errorf("invalid number %q", args[0])
+		}
+	}
+
+	if cmd == "up" {
+		if err := database.Create(c); err != nil {
+			return errors.Wrap(err, "Error creating database")
+		}
+	}
+	d, err := database.Open(c)
+	if err != nil {
+		return errors.Wrap(err, "Error opening database")
+	}
+	defer d.Close()
+	m, err := New(d)
+	if err != nil {
+		return err
+	}
+
+	switch cmd {
+	case "up":
+		applied, err := m.Up()
+		fmt.Fprintf(w, "Applied %d migrations\n", applied)
+		return err
+	case "down":
+		reverted, err := m.Down(n)
+		fmt.Fprintf(w, "Reverted %d migrations\n", reverted)
+		return err
+	case "force":
+		return m.Force(n)
+	case "status":
+		status, err := m.Status()
+		if err != nil {
+			return err
+		}
+		for _, s := range status {
+			fmt.Fprintln(w, s)
+		}
+		return nil
+	case "version":
+		version, dirty, err := m.Version()
+		if err != nil {
+			return err
+		}
+		if dirty {
+			fmt.Fprintf(w, "%d (dirty)\n", version)
+		} else {
+			fmt.Fprintln(w, version)
+		}
+	}
+	return nil
+}
+
+// Startup prepares the schema of d when a binary starts. With auto set it
+// applies pending migrations first. It fails unless the schema is at the
+// version the binary was built for.
+func Startup(d *database.SQLDatabase, auto bool) error {
+	m, err := New(d)
+	if err != nil {
+		return err
+	}
+	if auto {
+		applied, err := m.Up()
+		if err != nil {
+			return errors.Wrap(err, "Error migrating database")
+		}
+		if applied > 0 {
+			log.WithFields(log.Fields{"event": "migrate", "status": "success", "version": m.Latest()}).Infof("Applied %d migrations", applied)
+		}
+	}
+	return errors.Wrap(m.Check(), "Database schema is not up to date, run migrate up")
+}
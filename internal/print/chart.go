@@ -0,0 +1,213 @@
+package print
+
+import (
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Simek13/satelliteApp/internal/report"
+)
+
+const (
+	ChartNone = ""
+	ChartBar  = "bar"
+	ChartLine = "line"
+
+	lineChartHeight = 8
+	maxSparkline    = 40
+)
+
+// ChartModes lists the chart modes accepted by TextRenderer.
+var ChartModes = []string{ChartBar, ChartLine}
+
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// sparkline draws values as a line of unicode blocks, at most width runes
+// long. Longer series are averaged into width buckets. Values that are not
+// finite are left blank.
+func sparkline(values []float64, width int) string {
+	if len(values) == 0 || width <= 0 {
+		return ""
+	}
+	values = downsample(values, width)
+
+	min, max, ok := bounds(values)
+	if !ok {
+		return ""
+	}
+
+	var sb strings.Builder
+	for _, v := range values {
+		if !finite(v) {
+			sb.WriteRune(' ')
+			continue
+		}
+		i := 0
+		if max > min {
+			i = int(math.Round((v - min) / (max - min) * float64(len(sparkBlocks)-1)))
+		}
+		sb.WriteRune(sparkBlocks[i])
+	}
+	return sb.String()
+}
+
+func downsample(values []float64, width int) []float64 {
+	if len(values) <= width {
+		return values
+	}
+	out := make([]float64, width)
+	for i := range out {
+		from := i * len(values) / width
+		to := (i + 1) * len(values) / width
+		sum, n := 0.0, 0
+		for _, v := range values[from:to] {
+			if finite(v) {
+				sum += v
+				n++
+			}
+		}
+		out[i] = math.NaN()
+		if n > 0 {
+			out[i] = sum / float64(n)
+		}
+	}
+	return out
+}
+
+func finite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
+
+// bounds returns the smallest and largest finite value, and whether there
+// is one.
+func bounds(values []float64) (min, max float64, ok bool) {
+	min, max = math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		if finite(v) {
+			min = math.Min(min, v)
+			max = math.Max(max, v)
+			ok = true
+		}
+	}
+	return min, max, ok
+}
+
+func seriesValues(s report.Series) []float64 {
+	values := make([]float64, 0, len(s.Points))
+	for _, p := range s.Points {
+		values = append(values, p.Value)
+	}
+	return values
+}
+
+// barChart draws one horizontal bar per ranking entry, scaled so that the
+// largest absolute value fills the available width. Entries that are not
+// finite have no bar.
+func barChart(ew *errWriter, rk report.Ranking, width int) {
+	if len(rk.Entries) == 0 {
+		return
+	}
+
+	labelWidth, valueWidth, maxAbs := 0, 0, 0.0
+	for _, e := range rk.Entries {
+		labelWidth = maxInt(labelWidth, utf8.RuneCountInString(e.Satellite))
+		valueWidth = maxInt(valueWidth, len(formatFloat(e.Value)))
+		if finite(e.Value) {
+			maxAbs = math.Max(maxAbs, math.Abs(e.Value))
+		}
+	}
+	barWidth := maxInt(width-labelWidth-valueWidth-3, 1)
+
+	for _, e := range rk.Entries {
+		n := 0
+		if maxAbs > 0 && finite(e.Value) {
+			n = int(math.Round(math.Abs(e.Value) / maxAbs * float64(barWidth)))
+		}
+		ew.printf("%-*s |%s %s\n", labelWidth, e.Satellite, strings.Repeat("#", n), formatFloat(e.Value))
+	}
+}
+
+// lineChart plots the ranking entries in ranking order on a fixed height
+// grid, with the satellites spread evenly over the available width. Entries
+// that are not finite are not plotted.
+func lineChart(ew *errWriter, rk report.Ranking, width int) {
+	values := make([]float64, 0, len(rk.Entries))
+	for _, e := range rk.Entries {
+		values = append(values, e.Value)
+	}
+	min, max, ok := bounds(values)
+	if !ok {
+		return
+	}
+	axisWidth := maxInt(len(formatFloat(min)), len(formatFloat(max)))
+	plotWidth := maxInt(width-axisWidth-2, len(rk.Entries))
+
+	row := func(v float64) int {
+		if max == min {
+			return lineChartHeight / 2
+		}
+		return int(math.Round((max - v) / (max - min) * float64(lineChartHeight-1)))
+	}
+	col := func(i int) int {
+		if len(rk.Entries) == 1 {
+			return 0
+		}
+		return i * (plotWidth - 1) / (len(rk.Entries) - 1)
+	}
+
+	grid := make([][]rune, lineChartHeight)
+	for i := range grid {
+		grid[i] = []rune(strings.Repeat(" ", plotWidth))
+	}
+	for i := 1; i < len(rk.Entries); i++ {
+		if !finite(rk.Entries[i-1].Value) || !finite(rk.Entries[i].Value) {
+			continue
+		}
+		x0, y0 := col(i-1), row(rk.Entries[i-1].Value)
+		x1, y1 := col(i), row(rk.Entries[i].Value)
+		for x := x0 + 1; x < x1; x++ {
+			y := y0 + int(math.Round(float64((y1-y0)*(x-x0))/float64(x1-x0)))
+			grid[y][x] = '.'
+		}
+	}
+	for i, e := range rk.Entries {
+		if finite(e.Value) {
+			grid[row(e.Value)][col(i)] = '*'
+		}
+	}
+
+	for y, line := range grid {
+		label := ""
+		switch y {
+		case row(max):
+			label = formatFloat(max)
+		case row(min):
+			label = formatFloat(min)
+		}
+		ew.printf("%*s |%s\n", axisWidth, label, strings.TrimRight(string(line), " "))
+	}
+	ew.printf("%*s +%s\n", axisWidth, "", strings.Repeat("-", plotWidth))
+
+	labels := []rune(strings.Repeat(" ", plotWidth))
+	next := 0
+	for i, e := range rk.Entries {
+		name := []rune(e.Satellite)
+		x := col(i)
+		if i == len(rk.Entries)-1 && len(rk.Entries) > 1 {
+			x = maxInt(plotWidth-len(name), 0)
+		}
+		if x < next || x+len(name) > plotWidth {
+			continue
+		}
+		copy(labels[x:], name)
+		next = x + len(name) + 1
+	}
+	ew.printf("%*s  %s\n", axisWidth, "", strings.TrimRight(string(labels), " "))
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
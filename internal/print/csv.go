@@ -0,0 +1,48 @@
+package print
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/Simek13/satelliteApp/internal/report"
+)
+
+// CSVRenderer writes the report as a single long-format table, one value per
+// row, so that every section can be filtered with ordinary csv tools.
+type CSVRenderer struct{}
+
+var csvHeader = []string{"section", "satellite", "channel", "metric", "value", "rank"}
+
+func (CSVRenderer) Render(w io.Writer, r *report.Report) error {
+	cw := csv.NewWriter(w)
+	records := [][]string{csvHeader}
+
+	for _, mt := range r.MeasurementTimes {
+		records = append(records, []string{"measurementTime", mt.Satellite, "", "duration", mt.Duration.String(), ""})
+	}
+	for _, s := range r.Satellites {
+		for _, st := range s.Stats {
+			records = append(records,
+				[]string{"stat", s.Satellite, st.Channel, "min", formatFloat(st.Min), ""},
+				[]string{"stat", s.Satellite, st.Channel, "max", formatFloat(st.Max), ""},
+				[]string{"stat", s.Satellite, st.Channel, "avg", formatFloat(st.Avg), ""},
+			)
+		}
+	}
+	for _, rk := range r.Rankings {
+		for _, e := range rk.Entries {
+			records = append(records, []string{"ranking", e.Satellite, rk.Channel, "avg", formatFloat(e.Value), strconv.Itoa(e.Rank)})
+		}
+	}
+
+	for _, n := range r.Notes {
+		records = append(records, []string{"note", n.Satellite, "", "message", n.Message, ""})
+	}
+
+	return cw.WriteAll(records)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
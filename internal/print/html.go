@@ -0,0 +1,110 @@
+package print
+
+import (
+	_ "embed"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/Simek13/satelliteApp/internal/report"
+)
+
+const (
+	chartWidth   = 360
+	chartHeight  = 120
+	chartPadding = 6
+)
+
+//go:embed templates/report.html
+var htmlTemplate string
+
+var reportTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"label":        channelLabel,
+	"rankingLabel": rankingLabel,
+	"float":        formatFloat,
+	"chart":        newChart,
+}).Parse(htmlTemplate))
+
+// HTMLRenderer writes the report as a self-contained html page. Charts are
+// drawn as inline svg so the page has no external assets.
+type HTMLRenderer struct {
+	// Generated is shown in the page footer. It is left out when zero.
+	Generated time.Time
+}
+
+func (h HTMLRenderer) Render(w io.Writer, r *report.Report) error {
+	return reportTemplate.Execute(w, struct {
+		*report.Report
+		Generated time.Time
+	}{r, h.Generated})
+}
+
+// chart is a line chart of one series, scaled to the svg viewport.
+type chart struct {
+	Width, Height int
+	Points        string
+	Dots          []dot
+	Min, Max      float64
+	Start, End    time.Time
+}
+
+type dot struct {
+	X, Y  float64
+	Title string
+}
+
+func newChart(s report.Series) chart {
+	c := chart{Width: chartWidth, Height: chartHeight}
+	if len(s.Points) == 0 {
+		return c
+	}
+
+	c.Min, c.Max = s.Points[0].Value, s.Points[0].Value
+	c.Start, c.End = s.Points[0].Time, s.Points[len(s.Points)-1].Time
+	for _, p := range s.Points {
+		if p.Value < c.Min {
+			c.Min = p.Value
+		}
+		if p.Value > c.Max {
+			c.Max = p.Value
+		}
+	}
+
+	span := c.End.Sub(c.Start)
+	valueRange := c.Max - c.Min
+	plotWidth := float64(chartWidth - 2*chartPadding)
+	plotHeight := float64(chartHeight - 2*chartPadding)
+
+	points := make([]string, 0, len(s.Points))
+	for i, p := range s.Points {
+		x := plotWidth / 2
+		if span > 0 {
+			x = plotWidth * float64(p.Time.Sub(c.Start)) / float64(span)
+		} else if len(s.Points) > 1 {
+			x = plotWidth * float64(i) / float64(len(s.Points)-1)
+		}
+		y := plotHeight / 2
+		if valueRange > 0 {
+			y = plotHeight * (c.Max - p.Value) / valueRange
+		}
+		x += chartPadding
+		y += chartPadding
+		points = append(points, fmt.Sprintf("%.1f,%.1f", x, y))
+		c.Dots = append(c.Dots, dot{x, y, fmt.Sprintf("%s: %s", p.Time.Format("2006-01-02 15:04"), formatFloat(p.Value))})
+	}
+	c.Points = strings.Join(points, " ")
+
+	return c
+}
+
+func channelLabel(key string) string {
+	c, _ := report.ChannelByKey(key)
+	return c.Label
+}
+
+func rankingLabel(key string) string {
+	c, _ := report.ChannelByKey(key)
+	return c.RankingLabel
+}
@@ -0,0 +1,50 @@
+package print
+
+import (
+	"io"
+
+	"github.com/Simek13/satelliteApp/internal/report"
+)
+
+type MarkdownRenderer struct{}
+
+func (MarkdownRenderer) Render(w io.Writer, r *report.Report) error {
+	ew := &errWriter{w: w}
+
+	ew.printf("# Satellite report: %s\n\n", r.FileName)
+
+	ew.printf("## Measurement times\n\n")
+	ew.printf("| Satellite | Duration |\n|---|---|\n")
+	for _, mt := range r.MeasurementTimes {
+		ew.printf("| %s | %s |\n", mt.Satellite, mt.Duration)
+	}
+
+	ew.printf("\n## Statistics\n\n")
+	ew.printf("| Satellite | Type | Channel | Min | Max | Avg |\n|---|---|---|---:|---:|---:|\n")
+	for _, s := range r.Satellites {
+		for _, st := range s.Stats {
+			c, _ := report.ChannelByKey(st.Channel)
+			ew.printf("| %s | %s | %s | %s | %s | %s |\n", s.Satellite, s.Type, c.Label,
+				formatFloat(st.Min), formatFloat(st.Max), formatFloat(st.Avg))
+		}
+	}
+
+	ew.printf("\n## Averages rankings\n")
+	for _, rk := range r.Rankings {
+		c, _ := report.ChannelByKey(rk.Channel)
+		ew.printf("\n### %s\n\n", c.RankingLabel)
+		ew.printf("| Rank | Satellite | Average |\n|---:|---|---:|\n")
+		for _, e := range rk.Entries {
+			ew.printf("| %d | %s | %s |\n", e.Rank, e.Satellite, formatFloat(e.Value))
+		}
+	}
+
+	if len(r.Notes) > 0 {
+		ew.printf("\n## Data quality\n\n")
+		for _, n := range r.Notes {
+			ew.printf("- **%s**: %s\n", n.Satellite, n.Message)
+		}
+	}
+
+	return ew.err
+}
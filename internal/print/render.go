@@ -0,0 +1,63 @@
+package print
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/Simek13/satelliteApp/internal/report"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	FormatText     = "text"
+	FormatJSON     = "json"
+	FormatCSV      = "csv"
+	FormatMarkdown = "markdown"
+	FormatYAML     = "yaml"
+	FormatHTML     = "html"
+)
+
+// Formats lists the output formats accepted by NewRenderer.
+var Formats = []string{FormatText, FormatJSON, FormatCSV, FormatMarkdown, FormatYAML, FormatHTML}
+
+type Renderer interface {
+	Render(w io.Writer, r *report.Report) error
+}
+
+func NewRenderer(format string) (Renderer, error) {
+	switch format {
+	case FormatText:
+		return &TextRenderer{Sparklines: true}, nil
+	case FormatJSON:
+		return &JSONRenderer{}, nil
+	case FormatCSV:
+		return &CSVRenderer{}, nil
+	case FormatMarkdown:
+		return &MarkdownRenderer{}, nil
+	case FormatYAML:
+		return &YAMLRenderer{}, nil
+	case FormatHTML:
+		return &HTMLRenderer{}, nil
+	}
+	return nil, fmt.Errorf("unknown output format %q", format)
+}
+
+type JSONRenderer struct{}
+
+func (JSONRenderer) Render(w io.Writer, r *report.Report) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+type YAMLRenderer struct{}
+
+func (YAMLRenderer) Render(w io.Writer, r *report.Report) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(r); err != nil {
+		return err
+	}
+	return enc.Close()
+}
@@ -0,0 +1,172 @@
+package print
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Simek13/satelliteApp/internal/report"
+	"github.com/Simek13/satelliteApp/internal/satellites"
+)
+
+func testReport() *report.Report {
+	sats := map[string]satellites.Satellite{
+		"30J14": &satellites.EaSatellite{
+			BasicSatellite: satellites.BasicSatellite{
+				Id:               "30J14",
+				Timestamps:       []time.Time{time.Date(2016, 02, 20, 15, 19, 0, 0, time.UTC), time.Date(2016, 02, 20, 15, 21, 0, 0, time.UTC)},
+				IonoIndexes:      []float64{5, 7},
+				NdviIndexes:      []float64{29, 33},
+				RadiationIndexes: []float64{32, 32.4},
+				SatelliteType:    satellites.Ea,
+			},
+			Altitudes: []float64{830.9, 833.3},
+		},
+		"8J14": &satellites.VcSatellite{
+			BasicSatellite: satellites.BasicSatellite{
+				Id:               "8J14",
+				Timestamps:       []time.Time{time.Date(2016, 02, 20, 15, 34, 0, 0, time.UTC)},
+				IonoIndexes:      []float64{10},
+				NdviIndexes:      []float64{49},
+				RadiationIndexes: []float64{41},
+				SatelliteType:    satellites.Vc,
+			},
+			Vegetations: []string{"WOODS"},
+		},
+	}
+	return report.Build("happypath.csv", sats)
+}
+
+func TestRenderers(t *testing.T) {
+	tests := []struct {
+		format string
+		golden string
+	}{
+		{FormatText, "fixtures/report.txt"},
+		{FormatCSV, "fixtures/report.csv"},
+		{FormatMarkdown, "fixtures/report.md"},
+		{FormatYAML, "fixtures/report.yaml"},
+		{FormatJSON, "fixtures/report.json"},
+		{FormatHTML, "fixtures/report.html"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			renderer, err := NewRenderer(tt.format)
+			if err != nil {
+				t.Fatalf("NewRenderer() error = %v", err)
+			}
+			var buf bytes.Buffer
+			if err := renderer.Render(&buf, testReport()); err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			want, err := os.ReadFile(tt.golden)
+			if err != nil {
+				t.Fatalf("Error reading golden file, %v", err)
+			}
+			if got := buf.String(); got != string(want) {
+				t.Errorf("Render() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestTextRendererCharts(t *testing.T) {
+	tests := []struct {
+		chart  string
+		golden string
+	}{
+		{ChartBar, "fixtures/report_bar.txt"},
+		{ChartLine, "fixtures/report_line.txt"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.chart, func(t *testing.T) {
+			var buf bytes.Buffer
+			renderer := TextRenderer{Chart: tt.chart, Width: 60}
+			if err := renderer.Render(&buf, testReport()); err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			want, err := os.ReadFile(tt.golden)
+			if err != nil {
+				t.Fatalf("Error reading golden file, %v", err)
+			}
+			if got := buf.String(); got != string(want) {
+				t.Errorf("Render() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestSparkline(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		width  int
+		want   string
+	}{
+		{"empty", nil, 10, ""},
+		{"flat", []float64{3, 3, 3}, 10, "▁▁▁"},
+		{"rising", []float64{0, 1, 2, 3, 4, 5, 6, 7}, 10, "▁▂▃▄▅▆▇█"},
+		{"downsampled", []float64{0, 0, 7, 7}, 2, "▁█"},
+		{"no room", []float64{1, 2}, 0, ""},
+		{"not finite", []float64{0, math.NaN(), 7, math.Inf(1)}, 10, "▁ █ "},
+		{"only nan", []float64{math.NaN()}, 10, ""},
+		{"downsampled nan", []float64{0, math.NaN(), 7, 7}, 2, "▁█"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sparkline(tt.values, tt.width); got != tt.want {
+				t.Errorf("sparkline() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChartsNotFinite(t *testing.T) {
+	rk := report.Ranking{Channel: "iono", Entries: []report.RankEntry{
+		{Rank: 1, Satellite: "30J14", Value: 3},
+		{Rank: 2, Satellite: "8J14", Value: math.NaN()},
+		{Rank: 3, Satellite: "6N14", Value: 1},
+		{Rank: 4, Satellite: "9N14", Value: math.Inf(-1)},
+	}}
+
+	var bar bytes.Buffer
+	barChart(&errWriter{w: &bar}, rk, 40)
+	for _, line := range strings.Split(strings.TrimSpace(bar.String()), "\n") {
+		if hasBar := strings.Contains(line, "#"); hasBar != (strings.HasPrefix(line, "30J14") || strings.HasPrefix(line, "6N14")) {
+			t.Errorf("barChart() line %q, want bars only for finite values", line)
+		}
+	}
+
+	var line bytes.Buffer
+	lineChart(&errWriter{w: &line}, rk, 40)
+	if points := strings.Count(line.String(), "*"); points != 2 {
+		t.Errorf("lineChart() plotted %d points, want 2:\n%s", points, line.String())
+	}
+}
+
+func TestJSONRendererRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (JSONRenderer{}).Render(&buf, testReport()); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	var got map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("Render() produced invalid json: %v", err)
+	}
+	if got["fileName"] != "happypath.csv" {
+		t.Errorf("fileName = %v, want happypath.csv", got["fileName"])
+	}
+}
+
+func TestNewRendererUnknown(t *testing.T) {
+	if _, err := NewRenderer("xml"); err == nil {
+		t.Errorf("NewRenderer(xml) error = nil, want error")
+	}
+}
@@ -0,0 +1,27 @@
+package print
+
+import (
+	"os"
+	"strconv"
+
+	"golang.org/x/term"
+)
+
+// DefaultWidth is the line width used when the output is not a terminal.
+const DefaultWidth = 80
+
+// Terminal reports the width of f and whether it is a terminal. A valid
+// COLUMNS environment variable overrides the detected width.
+func Terminal(f *os.File) (width int, tty bool) {
+	width = DefaultWidth
+	tty = term.IsTerminal(int(f.Fd()))
+	if tty {
+		if w, _, err := term.GetSize(int(f.Fd())); err == nil && w > 0 {
+			width = w
+		}
+	}
+	if w, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && w > 0 {
+		width = w
+	}
+	return width, tty
+}
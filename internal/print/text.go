@@ -0,0 +1,106 @@
+package print
+
+import (
+	"fmt"
+	"io"
+	"unicode/utf8"
+
+	"github.com/Simek13/satelliteApp/internal/report"
+)
+
+// TextRenderer writes the report in the free-form layout of the command
+// line, measurement times first, then statistics and rankings.
+type TextRenderer struct {
+	// Sparklines appends a unicode sparkline of the raw values to every
+	// channel statistics line. Like Chart, it should only be set when writing
+	// to a terminal.
+	Sparklines bool
+	// Chart draws the averages rankings as ChartBar or ChartLine charts
+	// instead of plain lists. It should only be set when writing to a terminal.
+	Chart string
+	// Width is the line width charts and sparklines are fitted to. Zero means
+	// DefaultWidth.
+	Width int
+}
+
+func (t TextRenderer) Render(w io.Writer, r *report.Report) error {
+	ew := &errWriter{w: w}
+	width := t.Width
+	if width <= 0 {
+		width = DefaultWidth
+	}
+
+	for _, mt := range r.MeasurementTimes {
+		ew.println(mt.Satellite, "-", mt.Duration)
+	}
+	ew.println()
+
+	for _, s := range r.Satellites {
+		ew.println("Satellite: ", s.Satellite)
+		for _, st := range s.Stats {
+			c, _ := report.ChannelByKey(st.Channel)
+			line := fmt.Sprintln(c.Label+":", st.Min, "(MIN)", st.Max, "(MAX)", st.Avg, "(AVG)")
+			line = line[:len(line)-1]
+			if t.Sparklines {
+				if series, ok := findSeries(s.Series, st.Channel); ok {
+					sparkWidth := width - utf8.RuneCountInString(line) - 1
+					if sparkWidth > maxSparkline {
+						sparkWidth = maxSparkline
+					}
+					if spark := sparkline(seriesValues(series), sparkWidth); spark != "" {
+						line += " " + spark
+					}
+				}
+			}
+			ew.println(line)
+		}
+		ew.println()
+	}
+
+	for _, rk := range r.Rankings {
+		c, _ := report.ChannelByKey(rk.Channel)
+		ew.println("@" + c.RankingLabel + ":")
+		switch t.Chart {
+		case ChartBar:
+			barChart(ew, rk, width)
+		case ChartLine:
+			lineChart(ew, rk, width)
+		default:
+			for _, e := range rk.Entries {
+				ew.println(fmt.Sprintf("%v - %v", e.Satellite, e.Value))
+			}
+		}
+	}
+
+	return ew.err
+}
+
+func findSeries(series []report.Series, channel string) (report.Series, bool) {
+	for _, s := range series {
+		if s.Channel == channel {
+			return s, true
+		}
+	}
+	return report.Series{}, false
+}
+
+// errWriter remembers the first write error so rendering code can print
+// line after line without checking each one.
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (ew *errWriter) println(a ...interface{}) {
+	if ew.err != nil {
+		return
+	}
+	_, ew.err = fmt.Fprintln(ew.w, a...)
+}
+
+func (ew *errWriter) printf(format string, a ...interface{}) {
+	if ew.err != nil {
+		return
+	}
+	_, ew.err = fmt.Fprintf(ew.w, format, a...)
+}
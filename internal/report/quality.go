@@ -0,0 +1,75 @@
+package report
+
+import (
+	"fmt"
+	gosort "sort"
+	"time"
+
+	"github.com/Simek13/satelliteApp/internal/satellites"
+)
+
+// gapFactor is how many times longer than the median sampling interval a gap
+// between two measurements has to be before it is reported.
+const gapFactor = 5
+
+// Note is a data quality remark about the measurements of one satellite.
+type Note struct {
+	Satellite string `json:"satellite" yaml:"satellite"`
+	Message   string `json:"message" yaml:"message"`
+}
+
+func qualityNotes(sat satellites.Satellite) []Note {
+	bSat := sat.GetSatellite()
+	notes := make([]Note, 0)
+	add := func(format string, a ...interface{}) {
+		notes = append(notes, Note{bSat.Id, fmt.Sprintf(format, a...)})
+	}
+
+	if len(bSat.Timestamps) == 1 {
+		add("only one measurement, measurement time is zero")
+	}
+
+	outOfOrder := 0
+	for i := 1; i < len(bSat.Timestamps); i++ {
+		if bSat.Timestamps[i].Before(bSat.Timestamps[i-1]) {
+			outOfOrder++
+		}
+	}
+	if outOfOrder > 0 {
+		add("%d measurements are out of time order", outOfOrder)
+	}
+
+	sorted := make([]time.Time, len(bSat.Timestamps))
+	copy(sorted, bSat.Timestamps)
+	gosort.Slice(sorted, func(i, j int) bool { return sorted[i].Before(sorted[j]) })
+
+	duplicates := 0
+	intervals := make([]time.Duration, 0, len(sorted))
+	for i := 1; i < len(sorted); i++ {
+		d := sorted[i].Sub(sorted[i-1])
+		if d == 0 {
+			duplicates++
+			continue
+		}
+		intervals = append(intervals, d)
+	}
+	if duplicates > 0 {
+		add("%d measurements share a timestamp with another measurement", duplicates)
+	}
+
+	if len(intervals) > 2 {
+		byLength := make([]time.Duration, len(intervals))
+		copy(byLength, intervals)
+		gosort.Slice(byLength, func(i, j int) bool { return byLength[i] < byLength[j] })
+		median := byLength[len(byLength)/2]
+		if longest := byLength[len(byLength)-1]; longest > gapFactor*median {
+			add("longest gap between measurements is %v, median interval is %v", longest, median)
+		}
+	}
+
+	if _, ok := sat.(*satellites.VcSatellite); ok {
+		add("vegetation class is not numeric, no statistics are computed for it")
+	}
+
+	return notes
+}
@@ -0,0 +1,199 @@
+package report
+
+import (
+	gosort "sort"
+	"time"
+
+	"github.com/Simek13/satelliteApp/internal/satellites"
+	"github.com/Simek13/satelliteApp/internal/sort"
+)
+
+// Channel is one measured quantity of a satellite.
+type Channel struct {
+	Key          string
+	Label        string
+	RankingLabel string
+}
+
+var (
+	Ionosphere = Channel{"iono", "Ionosphere index", "Ionosphere index"}
+	NDVI       = Channel{"ndvi", "NDVI index", "NDVI index"}
+	Radiation  = Channel{"radiation", "Radiation index", "Radiation index"}
+	Altitude   = Channel{"altitude", "Earth altitude", "Earth altitude"}
+	Salinity   = Channel{"salinity", "Sea salinity index", "Sea salinity"}
+)
+
+// Channels lists every channel in report order.
+var Channels = []Channel{Ionosphere, NDVI, Radiation, Altitude, Salinity}
+
+// ChannelByKey returns the channel with the given key.
+func ChannelByKey(key string) (Channel, bool) {
+	for _, c := range Channels {
+		if c.Key == key {
+			return c, true
+		}
+	}
+	return Channel{}, false
+}
+
+// Duration is a time.Duration that is marshalled in its human readable form.
+type Duration time.Duration
+
+func (d Duration) String() string {
+	return time.Duration(d).String()
+}
+
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+type MeasurementTime struct {
+	Satellite string   `json:"satellite" yaml:"satellite"`
+	Duration  Duration `json:"duration" yaml:"duration"`
+}
+
+type Stat struct {
+	Channel string  `json:"channel" yaml:"channel"`
+	Min     float64 `json:"min" yaml:"min"`
+	Max     float64 `json:"max" yaml:"max"`
+	Avg     float64 `json:"avg" yaml:"avg"`
+}
+
+type Point struct {
+	Time  time.Time
+	Value float64
+}
+
+// Series holds the raw measurements of one channel in time order.
+type Series struct {
+	Channel string
+	Points  []Point
+}
+
+type SatelliteStats struct {
+	Satellite string   `json:"satellite" yaml:"satellite"`
+	Type      string   `json:"type" yaml:"type"`
+	Stats     []Stat   `json:"stats" yaml:"stats"`
+	Series    []Series `json:"-" yaml:"-"`
+}
+
+type RankEntry struct {
+	Rank      int     `json:"rank" yaml:"rank"`
+	Satellite string  `json:"satellite" yaml:"satellite"`
+	Value     float64 `json:"value" yaml:"value"`
+}
+
+type Ranking struct {
+	Channel string      `json:"channel" yaml:"channel"`
+	Entries []RankEntry `json:"entries" yaml:"entries"`
+}
+
+// Report is the result of evaluating one input file.
+type Report struct {
+	FileName         string            `json:"fileName" yaml:"fileName"`
+	MeasurementTimes []MeasurementTime `json:"measurementTimes" yaml:"measurementTimes"`
+	Satellites       []SatelliteStats  `json:"satellites" yaml:"satellites"`
+	Rankings         []Ranking         `json:"rankings" yaml:"rankings"`
+	Notes            []Note            `json:"notes,omitempty" yaml:"notes,omitempty"`
+}
+
+// Build computes the statistics of every satellite and collects them into a
+// report. Satellites are ordered by id. As a side effect the computed values
+// are stored on the satellites, ready to be persisted.
+func Build(filename string, sats map[string]satellites.Satellite) *Report {
+	ids := make([]string, 0, len(sats))
+	for id := range sats {
+		ids = append(ids, id)
+	}
+	gosort.Strings(ids)
+
+	r := &Report{
+		FileName:         filename,
+		MeasurementTimes: make([]MeasurementTime, 0, len(ids)),
+		Satellites:       make([]SatelliteStats, 0, len(ids)),
+	}
+
+	avgs := make(map[string]map[string]float64)
+	for _, c := range Channels {
+		avgs[c.Key] = make(map[string]float64)
+	}
+
+	for _, id := range ids {
+		sat := sats[id]
+		r.MeasurementTimes = append(r.MeasurementTimes, MeasurementTime{id, Duration(sat.MeasurementTime())})
+
+		ionoCalc, ndviCalc, radCalc, specCalc := sat.Compute()
+		stats := []Stat{
+			newStat(Ionosphere, ionoCalc),
+			newStat(NDVI, ndviCalc),
+			newStat(Radiation, radCalc),
+		}
+		switch sat.(type) {
+		case *satellites.EaSatellite:
+			stats = append(stats, newStat(Altitude, specCalc))
+		case *satellites.SsSatellite:
+			stats = append(stats, newStat(Salinity, specCalc))
+		}
+		for _, s := range stats {
+			avgs[s.Channel][id] = s.Avg
+		}
+
+		r.Satellites = append(r.Satellites, SatelliteStats{
+			Satellite: id,
+			Type:      sat.GetSatellite().SatelliteType.String(),
+			Stats:     stats,
+			Series:    newSeries(sat),
+		})
+		r.Notes = append(r.Notes, qualityNotes(sat)...)
+	}
+
+	for _, c := range Channels {
+		ranking := Ranking{Channel: c.Key, Entries: make([]RankEntry, 0)}
+		for _, e := range sort.RankValues(avgs[c.Key], sort.Descending, sort.Competition) {
+			ranking.Entries = append(ranking.Entries, RankEntry{e.Rank, e.Key, e.Values[sort.Value]})
+		}
+		r.Rankings = append(r.Rankings, ranking)
+	}
+
+	return r
+}
+
+func newStat(c Channel, calc []float64) Stat {
+	return Stat{Channel: c.Key, Min: calc[0], Max: calc[1], Avg: calc[2]}
+}
+
+func newSeries(sat satellites.Satellite) []Series {
+	bSat := sat.GetSatellite()
+	values := map[Channel][]float64{
+		Ionosphere: bSat.IonoIndexes,
+		NDVI:       bSat.NdviIndexes,
+		Radiation:  bSat.RadiationIndexes,
+	}
+	channels := []Channel{Ionosphere, NDVI, Radiation}
+	switch s := sat.(type) {
+	case *satellites.EaSatellite:
+		values[Altitude] = s.Altitudes
+		channels = append(channels, Altitude)
+	case *satellites.SsSatellite:
+		values[Salinity] = s.SeaSalinities
+		channels = append(channels, Salinity)
+	}
+
+	order := make([]int, len(bSat.Timestamps))
+	for i := range order {
+		order[i] = i
+	}
+	gosort.SliceStable(order, func(i, j int) bool {
+		return bSat.Timestamps[order[i]].Before(bSat.Timestamps[order[j]])
+	})
+
+	series := make([]Series, 0, len(channels))
+	for _, c := range channels {
+		s := Series{Channel: c.Key, Points: make([]Point, 0, len(order))}
+		for _, i := range order {
+			s.Points = append(s.Points, Point{bSat.Timestamps[i], values[c][i]})
+		}
+		series = append(series, s)
+	}
+	return series
+}
@@ -0,0 +1,96 @@
+package report
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Simek13/satelliteApp/internal/satellites"
+)
+
+func at(minute int) time.Time {
+	return time.Date(2016, 02, 20, 15, minute, 0, 0, time.UTC)
+}
+
+func basic(id string, t satellites.SatType, minutes ...int) satellites.BasicSatellite {
+	b := satellites.BasicSatellite{Id: id, SatelliteType: t}
+	for i, m := range minutes {
+		b.Timestamps = append(b.Timestamps, at(m))
+		b.IonoIndexes = append(b.IonoIndexes, float64(i+1))
+		b.NdviIndexes = append(b.NdviIndexes, 30)
+		b.RadiationIndexes = append(b.RadiationIndexes, float64(10*(i+1)))
+	}
+	return b
+}
+
+func TestBuild(t *testing.T) {
+	sats := map[string]satellites.Satellite{
+		"8J14":  &satellites.VcSatellite{BasicSatellite: basic("8J14", satellites.Vc, 5, 1), Vegetations: []string{"WOODS", "GRASS"}},
+		"30J14": &satellites.EaSatellite{BasicSatellite: basic("30J14", satellites.Ea, 0, 10, 20), Altitudes: []float64{830, 836, 833}},
+	}
+	r := Build("a.csv", sats)
+
+	wantTimes := []MeasurementTime{{"30J14", Duration(20 * time.Minute)}, {"8J14", Duration(4 * time.Minute)}}
+	if !reflect.DeepEqual(r.MeasurementTimes, wantTimes) {
+		t.Errorf("Build() measurement times = %v, want %v", r.MeasurementTimes, wantTimes)
+	}
+
+	wantStats := map[string][]Stat{
+		"30J14": {{"iono", 1, 3, 2}, {"ndvi", 30, 30, 30}, {"radiation", 10, 30, 20}, {"altitude", 830, 836, 833}},
+		"8J14":  {{"iono", 1, 2, 1.5}, {"ndvi", 30, 30, 30}, {"radiation", 10, 20, 15}},
+	}
+	for _, s := range r.Satellites {
+		if !reflect.DeepEqual(s.Stats, wantStats[s.Satellite]) {
+			t.Errorf("Build() stats of %s = %v, want %v", s.Satellite, s.Stats, wantStats[s.Satellite])
+		}
+	}
+
+	// the series of 8J14 are in time order, not in file order
+	series := r.Satellites[1].Series[0]
+	wantSeries := Series{Channel: "iono", Points: []Point{{at(1), 2}, {at(5), 1}}}
+	if !reflect.DeepEqual(series, wantSeries) {
+		t.Errorf("Build() series = %v, want %v", series, wantSeries)
+	}
+
+	wantRankings := map[string][]RankEntry{
+		"iono":     {{1, "30J14", 2}, {2, "8J14", 1.5}},
+		"ndvi":     {{1, "30J14", 30}, {1, "8J14", 30}},
+		"altitude": {{1, "30J14", 833}},
+		"salinity": {},
+	}
+	for _, rk := range r.Rankings {
+		if want, ok := wantRankings[rk.Channel]; ok && !reflect.DeepEqual(rk.Entries, want) {
+			t.Errorf("Build() ranking of %s = %v, want %v", rk.Channel, rk.Entries, want)
+		}
+	}
+}
+
+func TestQualityNotes(t *testing.T) {
+	tests := []struct {
+		name string
+		sat  satellites.Satellite
+		want []string
+	}{
+		{"regular", &satellites.EaSatellite{BasicSatellite: basic("30J14", satellites.Ea, 0, 1, 2, 3), Altitudes: []float64{1, 2, 3, 4}}, []string{}},
+		{"one measurement", &satellites.EaSatellite{BasicSatellite: basic("30J14", satellites.Ea, 0), Altitudes: []float64{1}},
+			[]string{"only one measurement, measurement time is zero"}},
+		{"out of order and duplicate", &satellites.EaSatellite{BasicSatellite: basic("30J14", satellites.Ea, 2, 1, 1), Altitudes: []float64{1, 2, 3}},
+			[]string{"1 measurements are out of time order", "1 measurements share a timestamp with another measurement"}},
+		{"gap", &satellites.EaSatellite{BasicSatellite: basic("30J14", satellites.Ea, 0, 1, 2, 3, 20), Altitudes: []float64{1, 2, 3, 4, 5}},
+			[]string{"longest gap between measurements is 17m0s, median interval is 1m0s"}},
+		{"vegetation", &satellites.VcSatellite{BasicSatellite: basic("8J14", satellites.Vc, 0, 1), Vegetations: []string{"WOODS", "WOODS"}},
+			[]string{"vegetation class is not numeric, no statistics are computed for it"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make([]string, 0)
+			for _, n := range qualityNotes(tt.sat) {
+				got = append(got, n.Message)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("qualityNotes() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
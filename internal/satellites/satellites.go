@@ -20,6 +20,18 @@ const (
 	Ss
 )
 
+func (t SatType) String() string {
+	switch t {
+	case Ea:
+		return "Ea"
+	case Vc:
+		return "Vc"
+	case Ss:
+		return "Ss"
+	}
+	return "Unknown"
+}
+
 type BasicSatellite struct {
 	Id               string
 	Timestamps       []time.Time
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Simek13/satelliteApp/internal/database"
+	"github.com/Simek13/satelliteApp/internal/validate"
+)
+
+// storeError converts an error of a database read or write to a status with
+// the code of its kind. Errors about a known row carry its ResourceInfo.
+func storeError(err error, msg string) error {
+	err = database.ClassifyError(err)
+	code := codes.Internal
+	switch {
+	case errors.Is(err, database.ErrNotFound):
+		code = codes.NotFound
+	case errors.Is(err, database.ErrDuplicate):
+		code = codes.AlreadyExists
+	case errors.Is(err, database.ErrForeignKey):
+		code = codes.FailedPrecondition
+	case errors.Is(err, database.ErrVersionConflict):
+		code = codes.Aborted
+	case errors.Is(err, database.ErrUnavailable):
+		code = codes.Unavailable
+	case errors.Is(err, database.ErrInvalidPageToken):
+		return invalidArgument("page_token", "%v", err)
+	case errors.Is(err, database.ErrInvalid):
+		code = codes.InvalidArgument
+	}
+
+	st := status.Newf(code, "%s: %v", msg, err)
+	var dbErr *database.Error
+	if errors.As(err, &dbErr) && dbErr.Resource != "" {
+		st = withDetails(st, &errdetails.ResourceInfo{
+			ResourceType: dbErr.Resource,
+			ResourceName: dbErr.Key,
+			Description:  dbErr.Err.Error(),
+		})
+	}
+	return st.Err()
+}
+
+// invalidArgument returns an InvalidArgument status with a BadRequest
+// violation of field.
+func invalidArgument(field, format string, args ...interface{}) error {
+	msg := fmt.Sprintf(format, args...)
+	return badRequest(msg, []validate.Violation{{Field: field, Description: msg}})
+}
+
+// invalidRequest converts the error of a validation to an InvalidArgument
+// status with a BadRequest violation of every invalid field. Errors of the
+// validation itself, like failed satellite lookups, are converted like
+// storeError.
+func invalidRequest(err error, msg string) error {
+	var verr *validate.Error
+	if errors.As(err, &verr) {
+		return badRequest(fmt.Sprintf("%s: %v", msg, err), verr.Violations)
+	}
+	return storeError(err, msg)
+}
+
+func badRequest(msg string, violations []validate.Violation) error {
+	details := &errdetails.BadRequest{}
+	for _, v := range violations {
+		details.FieldViolations = append(details.FieldViolations, &errdetails.BadRequest_FieldViolation{Field: v.Field, Description: v.Description})
+	}
+	return withDetails(status.New(codes.InvalidArgument, msg), details).Err()
+}
+
+// withDetails returns st with details, or st itself if they can not be
+// added.
+func withDetails(st *status.Status, details ...proto.Message) *status.Status {
+	if detailed, err := st.WithDetails(details...); err == nil {
+		return detailed
+	}
+	return st
+}
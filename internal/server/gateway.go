@@ -0,0 +1,28 @@
+package server
+
+import (
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+)
+
+// NewGatewayMux returns a mux for the REST gateway of the API.
+func NewGatewayMux() *runtime.ServeMux {
+	return runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, &listingMarshaler{
+		JSONPb:  &runtime.JSONPb{OrigName: true},
+		listing: &runtime.JSONPb{OrigName: true, EmitDefaults: true},
+	}))
+}
+
+// listingMarshaler writes listings, the responses with a page token, with
+// their zero values, so an empty listing has an empty list rather than no
+// field at all. Other messages are written like by JSONPb.
+type listingMarshaler struct {
+	*runtime.JSONPb
+	listing *runtime.JSONPb
+}
+
+func (m *listingMarshaler) Marshal(v interface{}) ([]byte, error) {
+	if _, ok := v.(interface{ GetNextPageToken() string }); ok {
+		return m.listing.Marshal(v)
+	}
+	return m.JSONPb.Marshal(v)
+}
@@ -0,0 +1,217 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/Simek13/satelliteApp/internal/app"
+	"github.com/Simek13/satelliteApp/internal/database"
+	"github.com/Simek13/satelliteApp/internal/report"
+	"github.com/Simek13/satelliteApp/internal/validate"
+	pbv1 "github.com/Simek13/satelliteApp/pkg/v1"
+	pb "github.com/Simek13/satelliteApp/pkg/v2"
+)
+
+const (
+	// MaxIngestSize is the size of the largest file IngestFile accepts.
+	MaxIngestSize = 32 << 20
+	// ingestChunkSize is the size of the chunks the ingest handler sends.
+	ingestChunkSize = 64 << 10
+)
+
+func (s *Server) IngestFile(stream pb.SatelliteCommunication_IngestFileServer) error {
+	var fileName string
+	var data bytes.Buffer
+	for first := true; ; first = false {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if first {
+			fileName = chunk.GetFileName()
+		}
+		if data.Len()+len(chunk.GetData()) > MaxIngestSize {
+			return status.Errorf(codes.ResourceExhausted, "File is larger than %d bytes", MaxIngestSize)
+		}
+		data.Write(chunk.GetData())
+	}
+	if fileName == "" {
+		return invalidArgument("file_name", "File name is required")
+	}
+
+	pipeline := &app.Pipeline{
+		Loader:    app.ReaderLoader{FileName: fileName, Reader: &data},
+		Validator: app.BatchValidator{Satellites: s.db},
+		Computer:  app.ReportComputer{},
+		Persister: app.DBPersister{Db: s.db},
+	}
+	b, err := pipeline.Run(stream.Context())
+	if err != nil {
+		var stageErr *app.StageError
+		if errors.As(err, &stageErr) && (stageErr.Stage == "load" || stageErr.Stage == "validate") {
+			var verr *validate.Error
+			if errors.As(err, &verr) {
+				return badRequest(fmt.Sprintf("Cannot ingest %s: %v", fileName, err), verr.Violations)
+			}
+			return status.Errorf(codes.InvalidArgument, "Cannot ingest %s: %v", fileName, err)
+		}
+		return storeError(err, "Cannot ingest "+fileName)
+	}
+	s.hub.Notify()
+	return stream.SendAndClose(ingestReport(b))
+}
+
+// ingestReport converts the report of a persisted batch.
+func ingestReport(b *app.Batch) *pb.IngestReport {
+	durations := make(map[string]time.Duration, len(b.Report.MeasurementTimes))
+	for _, mt := range b.Report.MeasurementTimes {
+		durations[mt.Satellite] = time.Duration(mt.Duration)
+	}
+
+	r := &pb.IngestReport{
+		RunId:        int32(b.Run.Id),
+		FileName:     b.FileName,
+		Satellites:   int32(b.Run.Satellites),
+		Measurements: int32(b.Run.Measurements),
+		Computations: int32(b.Run.Computations),
+	}
+	for _, sat := range b.Report.Satellites {
+		r.Statistics = append(r.Statistics, &pb.SatelliteStatistics{
+			Satellite: sat.Satellite,
+			Type:      database.SatelliteTypeProtobuf(sat.Type),
+			Duration:  durationpb.New(durations[sat.Satellite]),
+			Channels:  channelStatistics(sat.Stats),
+		})
+	}
+	for _, note := range b.Report.Notes {
+		r.Notes = append(r.Notes, &pb.Note{Satellite: note.Satellite, Message: note.Message})
+	}
+	return r
+}
+
+func channelStatistics(stats []report.Stat) []*pb.ChannelStatistics {
+	channels := make([]*pb.ChannelStatistics, 0, len(stats))
+	for _, stat := range stats {
+		channels = append(channels, &pb.ChannelStatistics{Channel: stat.Channel, Min: stat.Min, Max: stat.Max, Avg: stat.Avg})
+	}
+	return channels
+}
+
+// IngestHandler serves POST requests with a multipart form by sending its
+// "file" part to client.IngestFile. Responses and errors are written like
+// those of mux.
+func IngestHandler(mux *runtime.ServeMux, client pb.SatelliteCommunicationClient) http.Handler {
+	return ingestHandler(mux, func(ctx context.Context, fileName string, r io.Reader) (proto.Message, error) {
+		return Ingest(ctx, client, fileName, r)
+	})
+}
+
+// V1IngestHandler is IngestHandler for a client of the v1 API.
+func V1IngestHandler(mux *runtime.ServeMux, client pbv1.SatelliteCommunicationClient) http.Handler {
+	return ingestHandler(mux, func(ctx context.Context, fileName string, r io.Reader) (proto.Message, error) {
+		stream, err := client.IngestFile(ctx)
+		if err != nil {
+			return nil, err
+		}
+		err = sendChunks(r, fileName, func(chunk *pb.IngestChunk) error {
+			return stream.Send(&pbv1.IngestChunk{FileName: chunk.GetFileName(), Data: chunk.GetData()})
+		})
+		if err != nil {
+			return nil, err
+		}
+		return stream.CloseAndRecv()
+	})
+}
+
+func ingestHandler(mux *runtime.ServeMux, ingest func(ctx context.Context, fileName string, r io.Reader) (proto.Message, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		ctx := r.Context()
+		_, marshaler := runtime.MarshalerForRequest(mux, r)
+		fail := func(err error) {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, err)
+		}
+		form, err := r.MultipartReader()
+		if err != nil {
+			fail(status.Errorf(codes.InvalidArgument, "Request is not a multipart form: %v", err))
+			return
+		}
+		for {
+			part, err := form.NextPart()
+			if err == io.EOF {
+				fail(status.Errorf(codes.InvalidArgument, "Form has no file part"))
+				return
+			}
+			if err != nil {
+				fail(status.Errorf(codes.InvalidArgument, "Cannot read form: %v", err))
+				return
+			}
+			if part.FormName() != "file" {
+				continue
+			}
+
+			rep, err := ingest(ctx, part.FileName(), part)
+			if err != nil {
+				fail(err)
+				return
+			}
+			runtime.ForwardResponseMessage(ctx, mux, marshaler, w, r, rep)
+			return
+		}
+	})
+}
+
+// Ingest sends the file read from r to client.IngestFile in chunks and
+// returns the report.
+func Ingest(ctx context.Context, client pb.SatelliteCommunicationClient, fileName string, r io.Reader) (*pb.IngestReport, error) {
+	stream, err := client.IngestFile(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := sendChunks(r, fileName, stream.Send); err != nil {
+		return nil, err
+	}
+	return stream.CloseAndRecv()
+}
+
+// sendChunks sends r in chunks, the first one with the file name. It stops
+// without an error when send fails, as the server ended the stream and its
+// status is returned by CloseAndRecv.
+func sendChunks(r io.Reader, fileName string, send func(*pb.IngestChunk) error) error {
+	buf := make([]byte, ingestChunkSize)
+	for first := true; ; first = false {
+		n, err := io.ReadFull(r, buf)
+		if err == io.EOF && !first {
+			return nil
+		}
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return status.Errorf(codes.InvalidArgument, "Cannot read file: %v", err)
+		}
+		chunk := &pb.IngestChunk{Data: buf[:n]}
+		if first {
+			chunk.FileName = fileName
+		}
+		if err := send(chunk); err != nil || n < len(buf) {
+			return nil
+		}
+	}
+}
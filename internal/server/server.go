@@ -0,0 +1,728 @@
+// Package server implements the SatelliteCommunication gRPC service.
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Simek13/satelliteApp/internal/database"
+	"github.com/Simek13/satelliteApp/internal/validate"
+	"github.com/Simek13/satelliteApp/internal/watch"
+	pb "github.com/Simek13/satelliteApp/pkg/v2"
+)
+
+type Server struct {
+	pb.UnimplementedSatelliteCommunicationServer
+
+	db  database.Store
+	hub *watch.Hub
+}
+
+// New returns a server of db. hub must publish the measurements of db, the
+// server notifies it of the measurements it stores.
+func New(db database.Store, hub *watch.Hub) *Server {
+	return &Server{db: db, hub: hub}
+}
+
+// Page sizes of listings when the request does not set one, and the largest
+// page size a request may ask for.
+const (
+	DefaultPageSize = 100
+	MaxPageSize     = 1000
+)
+
+func pageOf(size int32, token string, descending bool) (database.Page, error) {
+	switch {
+	case size < 0:
+		return database.Page{}, invalidArgument("page_size", "Page size %d is negative", size)
+	case size == 0:
+		size = DefaultPageSize
+	case size > MaxPageSize:
+		size = MaxPageSize
+	}
+	return database.Page{Size: int(size), Token: token, Descending: descending}, nil
+}
+
+func (s *Server) GetMeasurements(ctx context.Context, filter *pb.SatelliteFilter) (*pb.MeasurementResponse, error) {
+	page, err := pageOf(filter.GetPageSize(), filter.GetPageToken(), filter.GetDescending())
+	if err != nil {
+		return nil, err
+	}
+	measurements, next, err := s.db.ListMeasurements(database.MeasurementQuery{SatId: int(filter.GetSatId())}, page)
+	if err != nil {
+		return nil, storeError(err, fmt.Sprintf("Measurements for satellite: %d could not be read", filter.GetSatId()))
+	}
+	return newSatTypes(s.db).measurementResponse(measurements, next)
+}
+
+func (s *Server) GetMeasurementsBetween(ctx context.Context, filter *pb.TimestampFilter) (*pb.MeasurementResponse, error) {
+	q, err := queryOf(filter)
+	if err != nil {
+		return nil, err
+	}
+	page, err := pageOf(filter.GetPageSize(), filter.GetPageToken(), filter.GetDescending())
+	if err != nil {
+		return nil, err
+	}
+	measurements, next, err := s.db.ListMeasurements(q, page)
+	if err != nil {
+		return nil, storeError(err, fmt.Sprintf("Measurements for satellite: %d could not be read", filter.GetSatId()))
+	}
+	return newSatTypes(s.db).measurementResponse(measurements, next)
+}
+
+// StreamBatchSize is the number of measurements StreamMeasurements reads from
+// the database at a time.
+const StreamBatchSize = 500
+
+func (s *Server) StreamMeasurements(filter *pb.TimestampFilter, stream pb.SatelliteCommunication_StreamMeasurementsServer) error {
+	q, err := queryOf(filter)
+	if err != nil {
+		return err
+	}
+
+	// Send blocks while the client is not reading, so at most one batch is
+	// held in memory.
+	types := newSatTypes(s.db)
+	page := database.Page{Size: StreamBatchSize, Descending: filter.GetDescending()}
+	for {
+		if err := stream.Context().Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		measurements, next, err := s.db.ListMeasurements(q, page)
+		if err != nil {
+			return storeError(err, fmt.Sprintf("Measurements for satellite: %d could not be read", filter.GetSatId()))
+		}
+		for _, m := range measurements {
+			pbMeasurement, err := types.protobuf(&m)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(pbMeasurement); err != nil {
+				return err
+			}
+		}
+		if next == "" {
+			return nil
+		}
+		page.Token = next
+	}
+}
+
+// WatchMeasurements catches up on the measurements after after_id that were
+// published before the subscription started, then sends the ones published
+// by the hub. Both are in id order without gaps, so the id of the last
+// measurement a client received is safe to resume after.
+func (s *Server) WatchMeasurements(filter *pb.WatchFilter, stream pb.SatelliteCommunication_WatchMeasurementsServer) error {
+	if filter.GetAfterId() < 0 {
+		return invalidArgument("after_id", "Measurement id %d is negative", filter.GetAfterId())
+	}
+	f := watch.Filter{SatId: int(filter.GetSatId()), FileName: filter.GetFileName()}
+
+	// Subscribe before catching up, so measurements stored meanwhile are
+	// received by the subscription.
+	sub := s.hub.Subscribe(f)
+	defer sub.Close()
+
+	types := newSatTypes(s.db)
+
+	last := int(filter.GetAfterId())
+catchUp:
+	for last > 0 && last < sub.Since() {
+		if err := stream.Context().Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		measurements, err := s.db.MeasurementsAfter(last, StreamBatchSize)
+		if err != nil {
+			return storeError(err, fmt.Sprintf("Measurements after: %d could not be read", last))
+		}
+		for _, m := range measurements {
+			// the rest is received by the subscription
+			if m.Id > sub.Since() {
+				break catchUp
+			}
+			last = m.Id
+			if !f.Match(m) {
+				continue
+			}
+			pbMeasurement, err := types.protobuf(&m)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(pbMeasurement); err != nil {
+				return err
+			}
+		}
+		if len(measurements) < StreamBatchSize {
+			break
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		case m, ok := <-sub.C():
+			if !ok {
+				return status.Errorf(codes.Unavailable, "Watch ended: %v, resume after measurement %d", sub.Err(), last)
+			}
+			// already received before resuming
+			if m.Id <= last {
+				continue
+			}
+			pbMeasurement, err := types.protobuf(&m)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(pbMeasurement); err != nil {
+				return err
+			}
+			last = m.Id
+		}
+	}
+}
+
+// queryOf converts a time range filter to a query, rejecting invalid and
+// empty ranges.
+func queryOf(filter *pb.TimestampFilter) (database.MeasurementQuery, error) {
+	start, err := timeOf(filter.GetStartTime())
+	if err != nil {
+		return database.MeasurementQuery{}, invalidArgument("start_time", "Invalid start time: %v", err)
+	}
+	end, err := timeOf(filter.GetEndTime())
+	if err != nil {
+		return database.MeasurementQuery{}, invalidArgument("end_time", "Invalid end time: %v", err)
+	}
+	if !start.IsZero() && !end.IsZero() && !start.Before(end) {
+		return database.MeasurementQuery{}, invalidArgument("end_time", "Start time %v is not before end time %v", start, end)
+	}
+	return database.MeasurementQuery{SatId: int(filter.GetSatId()), Start: start, End: end}, nil
+}
+
+// timeOf converts an optional timestamp, returning the zero time if it is not
+// set.
+func timeOf(ts *timestamppb.Timestamp) (time.Time, error) {
+	if ts == nil {
+		return time.Time{}, nil
+	}
+	if err := ts.CheckValid(); err != nil {
+		return time.Time{}, err
+	}
+	return ts.AsTime(), nil
+}
+
+// satTypes looks up the types of the satellites of measurements, which
+// decide the case of their specific measurement, remembering them.
+type satTypes struct {
+	db    database.Store
+	types map[int]string
+}
+
+func newSatTypes(db database.Store) *satTypes {
+	return &satTypes{db: db, types: make(map[int]string)}
+}
+
+func (t *satTypes) protobuf(m *database.Measurement) (*pb.Measurement, error) {
+	satType, ok := t.types[m.IdSat]
+	if !ok {
+		sat, err := found(t.db.GetSatellite(m.IdSat))
+		if err != nil {
+			return nil, storeError(err, fmt.Sprintf("Satellite: %d could not be read", m.IdSat))
+		}
+		if sat != nil {
+			satType = sat.Type
+		}
+		t.types[m.IdSat] = satType
+	}
+	return m.Protobuf(satType), nil
+}
+
+func (t *satTypes) measurementResponse(measurements []database.Measurement, next string) (*pb.MeasurementResponse, error) {
+	pbMeasurements := make([]*pb.Measurement, 0, len(measurements))
+	for i := range measurements {
+		m, err := t.protobuf(&measurements[i])
+		if err != nil {
+			return nil, err
+		}
+		pbMeasurements = append(pbMeasurements, m)
+	}
+	return &pb.MeasurementResponse{Measurements: pbMeasurements, NextPageToken: next}, nil
+}
+
+func (s *Server) GetComputations(ctx context.Context, filter *pb.SatelliteFilter) (*pb.ComputationResponse, error) {
+	page, err := pageOf(filter.GetPageSize(), filter.GetPageToken(), filter.GetDescending())
+	if err != nil {
+		return nil, err
+	}
+	computations, next, err := s.db.ListComputations(int(filter.GetSatId()), page)
+	if err != nil {
+		return nil, storeError(err, fmt.Sprintf("Computations for satellite: %d could not be read", filter.GetSatId()))
+	}
+	pbComputations := make([]*pb.Computation, 0)
+	for _, c := range computations {
+		pbComputations = append(pbComputations, c.Protobuf())
+	}
+
+	computationResponse := &pb.ComputationResponse{Computations: pbComputations, NextPageToken: next}
+
+	return computationResponse, nil
+}
+
+func (s *Server) AddSatellite(ctx context.Context, rq *pb.Satellite) (*pb.Satellite, error) {
+	if err := checkSatellite(rq); err != nil {
+		return nil, invalidRequest(err, "Invalid satellite")
+	}
+	satellite := database.NewSatellite(rq)
+	err := s.db.AddSatellite(satellite)
+	if err != nil {
+		return nil, storeError(err, "Cannot add given satellite")
+	}
+
+	return satellite.Protobuf(), nil
+}
+
+func (s *Server) ListSatellites(ctx context.Context, rq *pb.ListSatellitesRequest) (*pb.SatelliteResponse, error) {
+	page, err := pageOf(rq.GetPageSize(), rq.GetPageToken(), rq.GetDescending())
+	if err != nil {
+		return nil, err
+	}
+	after, err := timeOf(rq.GetLaunchedAfter())
+	if err != nil {
+		return nil, invalidArgument("launched_after", "Invalid launched_after: %v", err)
+	}
+	before, err := timeOf(rq.GetLaunchedBefore())
+	if err != nil {
+		return nil, invalidArgument("launched_before", "Invalid launched_before: %v", err)
+	}
+	if _, ok := pb.SatelliteType_name[int32(rq.GetType())]; !ok {
+		return nil, invalidArgument("type", "Unknown satellite type %v", rq.GetType())
+	}
+	q := database.SatelliteQuery{
+		ShowDeleted:    rq.GetShowDeleted(),
+		Type:           database.SatelliteTypeOf(rq.GetType()),
+		Mission:        rq.GetMission(),
+		Operator:       rq.GetOperator(),
+		Status:         rq.GetStatus(),
+		Tag:            rq.GetTag(),
+		LaunchedAfter:  after,
+		LaunchedBefore: before,
+	}
+	sats, next, err := s.db.ListSatellites(q, page)
+	if err != nil {
+		return nil, storeError(err, "Satellites could not be read")
+	}
+	pbSatellites := make([]*pb.Satellite, 0, len(sats))
+	for _, sat := range sats {
+		pbSatellites = append(pbSatellites, sat.Protobuf())
+	}
+	return &pb.SatelliteResponse{Satellites: pbSatellites, NextPageToken: next}, nil
+}
+
+func (s *Server) GetSatellite(ctx context.Context, rq *pb.GetSatelliteRequest) (*pb.Satellite, error) {
+	var sat *database.Satellite
+	var err error
+	switch {
+	case rq.GetId() != 0:
+		sat, err = s.db.GetSatellite(int(rq.GetId()))
+	case rq.GetName() != "":
+		sat, err = s.db.GetSatelliteByName(rq.GetName())
+	default:
+		return nil, invalidArgument("id", "Satellite id or name is required")
+	}
+	if err != nil {
+		return nil, storeError(err, "Cannot get satellite")
+	}
+	return sat.Protobuf(), nil
+}
+
+func (s *Server) UpdateSatellite(ctx context.Context, rq *pb.UpdateSatelliteRequest) (*pb.Satellite, error) {
+	if rq.GetSatellite() == nil {
+		return nil, invalidArgument("satellite", "Satellite is required")
+	}
+	paths, err := updatePaths(rq.GetUpdateMask())
+	if err != nil {
+		return nil, err
+	}
+	columns, err := database.SatelliteColumns(paths)
+	if err != nil {
+		return nil, invalidArgument("update_mask", "Invalid update mask: %v", err)
+	}
+	if err := checkSatellite(rq.GetSatellite(), paths...); err != nil {
+		return nil, invalidRequest(prefixed(err, "satellite."), "Invalid satellite")
+	}
+
+	sat := database.NewSatellite(rq.GetSatellite())
+	if err := s.db.UpdateSatellite(sat, columns); err != nil {
+		return nil, storeError(err, "Cannot update satellite")
+	}
+	return sat.Protobuf(), nil
+}
+
+func (s *Server) DeleteSatellite(ctx context.Context, rq *pb.DeleteSatelliteRequest) (*emptypb.Empty, error) {
+	if err := checkId(rq.GetId()); err != nil {
+		return nil, invalidRequest(err, "Invalid satellite id")
+	}
+	var err error
+	if rq.GetSoft() {
+		err = s.db.SoftDeleteSatellite(int(rq.GetId()))
+	} else {
+		err = s.db.DeleteSatellite(int(rq.GetId()), rq.GetCascade())
+	}
+	if err != nil {
+		return nil, storeError(err, "Cannot delete satellite")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// updatePaths returns the paths of mask to write, nil to write every field.
+// The id and version of the message select the row and are not written.
+func updatePaths(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	if len(mask.GetPaths()) == 0 {
+		return nil, nil
+	}
+	paths := make([]string, 0, len(mask.GetPaths()))
+	for _, path := range mask.GetPaths() {
+		if path != "id" && path != "version" {
+			paths = append(paths, path)
+		}
+	}
+	if len(paths) == 0 {
+		return nil, invalidArgument("update_mask", "Update mask has no fields to update")
+	}
+	return paths, nil
+}
+
+func (s *Server) UpdateMeasurement(ctx context.Context, rq *pb.UpdateMeasurementRequest) (*pb.Measurement, error) {
+	if rq.GetMeasurement() == nil {
+		return nil, invalidArgument("measurement", "Measurement is required")
+	}
+	paths, err := updatePaths(rq.GetUpdateMask())
+	if err != nil {
+		return nil, err
+	}
+	columns, err := database.MeasurementColumns(paths)
+	if err != nil {
+		return nil, invalidArgument("update_mask", "Invalid update mask: %v", err)
+	}
+	if err := checkMeasurement(rq.GetMeasurement(), paths...); err != nil {
+		return nil, invalidRequest(prefixed(err, "measurement."), "Invalid measurement")
+	}
+	if selected(paths, "sat_id") {
+		if err := validate.SatelliteExists(s.db, "measurement.sat_id", int(rq.GetMeasurement().GetSatId())); err != nil {
+			return nil, invalidRequest(err, "Invalid measurement")
+		}
+	}
+
+	measurement := database.NewMeasurement(rq.GetMeasurement())
+	if err := s.db.UpdateMeasurement(measurement, columns); err != nil {
+		return nil, storeError(err, "Cannot update measurement")
+	}
+	return newSatTypes(s.db).protobuf(measurement)
+}
+
+func (s *Server) DeleteMeasurement(ctx context.Context, rq *pb.DeleteRequest) (*emptypb.Empty, error) {
+	if err := checkId(rq.GetId()); err != nil {
+		return nil, invalidRequest(err, "Invalid measurement id")
+	}
+	if err := s.db.DeleteMeasurement(int(rq.GetId()), int(rq.GetVersion())); err != nil {
+		return nil, storeError(err, "Cannot delete measurement")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// MaxStatisticsMeasurements is the largest number of measurements
+// ComputeStatistics reads, all of them are held in memory.
+const MaxStatisticsMeasurements = 100000
+
+func (s *Server) ComputeStatistics(ctx context.Context, rq *pb.ComputeStatisticsRequest) (*pb.ComputeStatisticsResponse, error) {
+	if rq.GetSatId() == 0 {
+		return nil, invalidArgument("sat_id", "Satellite id is required")
+	}
+	q, err := queryOf(&pb.TimestampFilter{SatId: rq.GetSatId(), StartTime: rq.GetStartTime(), EndTime: rq.GetEndTime()})
+	if err != nil {
+		return nil, err
+	}
+	for _, stat := range rq.GetStatistics() {
+		if _, ok := pb.Statistic_name[int32(stat)]; !ok || stat == pb.Statistic_STATISTIC_UNSPECIFIED {
+			return nil, invalidArgument("statistics", "Unknown statistic %v", stat)
+		}
+	}
+
+	sat, err := s.db.GetSatellite(q.SatId)
+	if err != nil {
+		return nil, storeError(err, "Cannot get satellite")
+	}
+	if sat.Deleted != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Satellite %d is deleted", q.SatId)
+	}
+	measurements, next, err := s.db.ListMeasurements(q, database.Page{Size: MaxStatisticsMeasurements})
+	if err != nil {
+		return nil, storeError(err, "Cannot read measurements")
+	}
+	if next != "" {
+		return nil, status.Errorf(codes.ResourceExhausted, "Satellite %d has more than %d measurements in the time range", q.SatId, MaxStatisticsMeasurements)
+	}
+	if len(measurements) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "Satellite %d has no measurements in the time range", q.SatId)
+	}
+	computed, err := database.SatelliteOf(sat, measurements)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Cannot compute statistics: %v", err)
+	}
+	computed.MeasurementTime()
+	computed.Compute()
+
+	// every statistic is stored, so the stored computation stays the one of
+	// the measurements, and only the response is limited to the selected ones
+	computation := database.ComputationOf(sat.Id, computed)
+	if rq.GetPersist() {
+		if err := s.storeComputation(computation); err != nil {
+			return nil, storeError(err, "Cannot store computation")
+		}
+	}
+	computation = selectStatistics(computation, rq.GetStatistics())
+	return &pb.ComputeStatisticsResponse{Computation: computation.Protobuf(), Measurements: int32(len(measurements))}, nil
+}
+
+// storeComputation stores c as the computation of its satellite, replacing
+// the one stored before.
+func (s *Server) storeComputation(c *database.Computation) error {
+	stored, err := s.db.GetComputations(c.IdSat)
+	if err != nil {
+		return err
+	}
+	if len(stored) == 0 {
+		return s.db.AddComputation(c)
+	}
+	columns, err := database.ComputationColumns(nil)
+	if err != nil {
+		return err
+	}
+	c.Id = stored[0].Id
+	return s.db.UpdateComputation(c, columns)
+}
+
+// selectStatistics returns the computation with only the fields of stats
+// set, or all of them if stats is empty.
+func selectStatistics(c *database.Computation, stats []pb.Statistic) *database.Computation {
+	if len(stats) == 0 {
+		return c
+	}
+	selected := &database.Computation{Id: c.Id, IdSat: c.IdSat, Version: c.Version}
+	for _, stat := range stats {
+		switch stat {
+		case pb.Statistic_STATISTIC_DURATION:
+			selected.Duration = c.Duration
+		case pb.Statistic_STATISTIC_IONO:
+			selected.MinIono, selected.MaxIono, selected.AvgIono = c.MinIono, c.MaxIono, c.AvgIono
+		case pb.Statistic_STATISTIC_NDVI:
+			selected.MinNdvi, selected.MaxNdvi, selected.AvgNdvi = c.MinNdvi, c.MaxNdvi, c.AvgNdvi
+		case pb.Statistic_STATISTIC_RADIATION:
+			selected.MinRad, selected.MaxRad, selected.AvgRad = c.MinRad, c.MaxRad, c.AvgRad
+		case pb.Statistic_STATISTIC_SPECIFIC:
+			selected.MinSpec, selected.MaxSpec, selected.AvgSpec = c.MinSpec, c.MaxSpec, c.AvgSpec
+		}
+	}
+	return selected
+}
+
+func (s *Server) UpdateComputation(ctx context.Context, rq *pb.UpdateComputationRequest) (*pb.Computation, error) {
+	if rq.GetComputation() == nil {
+		return nil, invalidArgument("computation", "Computation is required")
+	}
+	paths, err := updatePaths(rq.GetUpdateMask())
+	if err != nil {
+		return nil, err
+	}
+	columns, err := database.ComputationColumns(paths)
+	if err != nil {
+		return nil, invalidArgument("update_mask", "Invalid update mask: %v", err)
+	}
+	if err := checkComputation(rq.GetComputation(), paths...); err != nil {
+		return nil, invalidRequest(prefixed(err, "computation."), "Invalid computation")
+	}
+	if selected(paths, "sat_id") {
+		if err := validate.SatelliteExists(s.db, "computation.sat_id", int(rq.GetComputation().GetSatId())); err != nil {
+			return nil, invalidRequest(err, "Invalid computation")
+		}
+	}
+
+	computation := database.NewComputation(rq.GetComputation())
+	if err := s.db.UpdateComputation(computation, columns); err != nil {
+		return nil, storeError(err, "Cannot update computation")
+	}
+	return computation.Protobuf(), nil
+}
+
+func (s *Server) DeleteComputation(ctx context.Context, rq *pb.DeleteRequest) (*emptypb.Empty, error) {
+	if err := checkId(rq.GetId()); err != nil {
+		return nil, invalidRequest(err, "Invalid computation id")
+	}
+	if err := s.db.DeleteComputation(int(rq.GetId()), int(rq.GetVersion())); err != nil {
+		return nil, storeError(err, "Cannot delete computation")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) AddMeasurement(ctx context.Context, rq *pb.Measurement) (*pb.Measurement, error) {
+	if err := checkMeasurement(rq); err != nil {
+		return nil, invalidRequest(err, "Invalid measurement")
+	}
+	if err := validate.SatelliteExists(s.db, "sat_id", int(rq.GetSatId())); err != nil {
+		return nil, invalidRequest(err, "Invalid measurement")
+	}
+	measurement := database.NewMeasurement(rq)
+	err := s.db.AddMeasurement(measurement)
+	if err != nil {
+		return nil, storeError(err, "Cannot add given measurement")
+	}
+	s.hub.Notify()
+
+	return newSatTypes(s.db).protobuf(measurement)
+}
+
+// MaxUploadMeasurements is the largest number of measurements one
+// UploadMeasurements stream may send.
+const MaxUploadMeasurements = 100000
+
+// UploadMeasurements reads the whole upload before writing it, so the
+// transaction is only open while the accepted measurements are written. The
+// upload is held in memory, so it is limited to MaxUploadMeasurements.
+func (s *Server) UploadMeasurements(stream pb.SatelliteCommunication_UploadMeasurementsServer) error {
+	summary := &pb.UploadSummary{}
+	u := uploader{db: s.db, names: make(map[string]*database.Satellite), ids: make(map[int]*database.Satellite)}
+	var accepted []*database.Measurement
+	for index := int32(0); ; index++ {
+		rq, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if index == MaxUploadMeasurements {
+			return status.Errorf(codes.ResourceExhausted, "Upload has more than %d measurements", MaxUploadMeasurements)
+		}
+		m, reason, err := u.measurement(rq)
+		if err != nil {
+			return storeError(err, "Cannot upload measurements")
+		}
+		if reason != "" {
+			summary.Rejected = append(summary.Rejected, &pb.Rejection{Index: index, Reason: reason})
+			continue
+		}
+		accepted = append(accepted, m)
+	}
+
+	if len(accepted) > 0 {
+		run, err := s.db.IngestMeasurements(accepted[0].FileName, accepted)
+		if err != nil {
+			return storeError(err, "Cannot upload measurements")
+		}
+		summary.Accepted = int32(run.Measurements)
+		summary.RunId = int32(run.Id)
+		s.hub.Notify()
+	}
+	return stream.SendAndClose(summary)
+}
+
+// measurementValues are the fields of an uploaded measurement checked like
+// those of added ones. Its satellite may be named instead of having an id.
+var measurementValues = []string{"file_name", "timestamp", "iono_index", "ndvi_index", "radiation_index", "altitude", "sea_salinity", "vegetation", "other"}
+
+// uploader validates the measurements of one upload, remembering the
+// satellites it has looked up.
+type uploader struct {
+	db    database.Store
+	names map[string]*database.Satellite
+	ids   map[int]*database.Satellite
+}
+
+// measurement converts rq to a measurement to store. It returns the reason
+// if rq is rejected, and an error only if the database fails.
+func (u *uploader) measurement(rq *pb.UploadRequest) (*database.Measurement, string, error) {
+	if rq.GetMeasurement() == nil {
+		return nil, "measurement is missing", nil
+	}
+	ts := rq.GetMeasurement().GetTimestamp()
+	if ts == nil {
+		return nil, "timestamp is missing", nil
+	}
+	if err := ts.CheckValid(); err != nil {
+		return nil, fmt.Sprintf("invalid timestamp: %v", err), nil
+	}
+
+	m := database.NewMeasurement(rq.GetMeasurement())
+	m.Id = 0
+	m.Timestamp = m.Timestamp.UTC()
+	var sat *database.Satellite
+	switch {
+	case m.IdSat != 0:
+		var ok bool
+		if sat, ok = u.ids[m.IdSat]; !ok {
+			var err error
+			if sat, err = found(u.db.GetSatellite(m.IdSat)); err != nil {
+				return nil, "", err
+			}
+			u.ids[m.IdSat] = sat
+		}
+		if sat == nil {
+			return nil, fmt.Sprintf("satellite %d does not exist", m.IdSat), nil
+		}
+	case rq.GetSatellite() != "":
+		var ok bool
+		if sat, ok = u.names[rq.GetSatellite()]; !ok {
+			var err error
+			if sat, err = found(u.db.GetSatelliteByName(rq.GetSatellite())); err != nil {
+				return nil, "", err
+			}
+			u.names[rq.GetSatellite()] = sat
+		}
+		if sat == nil {
+			return nil, fmt.Sprintf("satellite %q does not exist", rq.GetSatellite()), nil
+		}
+		m.IdSat = sat.Id
+	default:
+		return nil, "satellite is missing", nil
+	}
+	if sat.Deleted != nil {
+		return nil, fmt.Sprintf("satellite %q is deleted", sat.Name), nil
+	}
+	if err := checkMeasurement(rq.GetMeasurement(), measurementValues...); err != nil {
+		return nil, err.Error(), nil
+	}
+	return m, "", nil
+}
+
+// found returns a nil satellite instead of ErrNotFound.
+func found(sat *database.Satellite, err error) (*database.Satellite, error) {
+	if errors.Is(err, database.ErrNotFound) {
+		return nil, nil
+	}
+	return sat, err
+}
+
+func (s *Server) AddComputation(ctx context.Context, rq *pb.Computation) (*pb.Computation, error) {
+	if err := checkComputation(rq); err != nil {
+		return nil, invalidRequest(err, "Invalid computation")
+	}
+	if err := validate.SatelliteExists(s.db, "sat_id", int(rq.GetSatId())); err != nil {
+		return nil, invalidRequest(err, "Invalid computation")
+	}
+	computation := database.NewComputation(rq)
+	err := s.db.AddComputation(computation)
+	if err != nil {
+		return nil, storeError(err, "Cannot add given computation")
+	}
+
+	return computation.Protobuf(), nil
+}
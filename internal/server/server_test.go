@@ -0,0 +1,957 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Simek13/satelliteApp/internal/csv"
+	"github.com/Simek13/satelliteApp/internal/database"
+	"github.com/Simek13/satelliteApp/internal/database/memory"
+	"github.com/Simek13/satelliteApp/internal/watch"
+	pb "github.com/Simek13/satelliteApp/pkg/v1"
+	pbv2 "github.com/Simek13/satelliteApp/pkg/v2"
+)
+
+func at(minute int) time.Time {
+	return time.Date(2016, 02, 20, 15, minute, 0, 0, time.UTC)
+}
+
+// newTestServer returns a server of a store holding satellite 1 with
+// measurements at minutes 10, 20 and 30 and satellite 2 with one at minute 20.
+func newTestServer(t *testing.T) *Server {
+	store := memory.New()
+	for _, name := range []string{"30J14", "8J14"} {
+		if err := store.AddSatellite(&database.Satellite{Name: name}); err != nil {
+			t.Fatalf("AddSatellite() error = %v", err)
+		}
+	}
+	for _, m := range []database.Measurement{
+		{FileName: "a.csv", IdSat: 1, Timestamp: at(30), IonoIndex: 30},
+		{FileName: "a.csv", IdSat: 1, Timestamp: at(10), IonoIndex: 10},
+		{FileName: "a.csv", IdSat: 2, Timestamp: at(20), IonoIndex: 20},
+		{FileName: "a.csv", IdSat: 1, Timestamp: at(20), IonoIndex: 21},
+	} {
+		if err := store.AddMeasurement(&m); err != nil {
+			t.Fatalf("AddMeasurement() error = %v", err)
+		}
+	}
+	hub, err := watch.NewHub(store, time.Hour)
+	if err != nil {
+		t.Fatalf("NewHub() error = %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go hub.Run(ctx)
+	return New(store, hub)
+}
+
+func ionoIndexes(measurements []*pb.Measurement) []float32 {
+	values := make([]float32, 0, len(measurements))
+	for _, m := range measurements {
+		values = append(values, m.GetIonoIndex())
+	}
+	return values
+}
+
+func TestGetMeasurementsBetween(t *testing.T) {
+	s := NewV1(newTestServer(t))
+
+	tests := []struct {
+		name     string
+		filter   *pb.TimestampFilter
+		want     []float32
+		wantCode codes.Code
+	}{
+		{"unbounded", &pb.TimestampFilter{SatId: 1}, []float32{10, 21, 30}, codes.OK},
+		{"range", &pb.TimestampFilter{SatId: 1, StartTime: timestamppb.New(at(20)), EndTime: timestamppb.New(at(30))}, []float32{21}, codes.OK},
+		{"every satellite", &pb.TimestampFilter{StartTime: timestamppb.New(at(15)), EndTime: timestamppb.New(at(25))}, []float32{20, 21}, codes.OK},
+		{"empty", &pb.TimestampFilter{SatId: 3}, []float32{}, codes.OK},
+		{"start after end", &pb.TimestampFilter{SatId: 1, StartTime: timestamppb.New(at(30)), EndTime: timestamppb.New(at(20))}, nil, codes.InvalidArgument},
+		{"invalid time", &pb.TimestampFilter{SatId: 1, StartTime: &timestamppb.Timestamp{Nanos: -1}}, nil, codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.GetMeasurementsBetween(context.Background(), tt.filter)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("GetMeasurementsBetween() code = %v, want %v (%v)", code, tt.wantCode, err)
+			}
+			if err != nil {
+				return
+			}
+			if values := ionoIndexes(got.GetMeasurements()); !reflect.DeepEqual(values, tt.want) {
+				t.Errorf("GetMeasurementsBetween() = %v, want %v", values, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetMeasurementsBetweenREST(t *testing.T) {
+	mux := NewGatewayMux()
+	if err := pb.RegisterSatelliteCommunicationHandlerServer(context.Background(), mux, NewV1(newTestServer(t))); err != nil {
+		t.Fatalf("RegisterSatelliteCommunicationHandlerServer() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		url        string
+		wantStatus int
+		want       []float32
+	}{
+		{"range", "/v1/measurements/1/between?startTime=2016-02-20T15:10:00Z&endTime=2016-02-20T15:30:00Z", http.StatusOK, []float32{10, 21}},
+		{"start only", "/v1/measurements/1/between?startTime=2016-02-20T17:20:00%2B02:00", http.StatusOK, []float32{21, 30}},
+		{"start after end", "/v1/measurements/1/between?startTime=2016-02-20T15:30:00Z&endTime=2016-02-20T15:10:00Z", http.StatusBadRequest, nil},
+		{"all measurements", "/v1/measurements/1", http.StatusOK, []float32{10, 21, 30}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.url, nil))
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("GET %s status = %d, want %d: %s", tt.url, rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.want == nil {
+				return
+			}
+			var got struct {
+				Measurements []struct {
+					IonoIndex float32 `json:"ionoIndex"`
+				} `json:"measurements"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+				t.Fatalf("Error decoding response, %v", err)
+			}
+			values := make([]float32, 0, len(got.Measurements))
+			for _, m := range got.Measurements {
+				values = append(values, m.IonoIndex)
+			}
+			if !reflect.DeepEqual(values, tt.want) {
+				t.Errorf("GET %s = %v, want %v", tt.url, values, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetMeasurementsPages(t *testing.T) {
+	s := NewV1(newTestServer(t))
+
+	tests := []struct {
+		name   string
+		filter *pb.SatelliteFilter
+		want   [][]float32
+	}{
+		{"default page size", &pb.SatelliteFilter{}, [][]float32{{10, 20, 21, 30}}},
+		{"ascending", &pb.SatelliteFilter{SatId: 1, PageSize: 2}, [][]float32{{10, 21}, {30}}},
+		{"descending", &pb.SatelliteFilter{PageSize: 3, Descending: true}, [][]float32{{30, 21, 20}, {10}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make([][]float32, 0)
+			for {
+				res, err := s.GetMeasurements(context.Background(), tt.filter)
+				if err != nil {
+					t.Fatalf("GetMeasurements() error = %v", err)
+				}
+				got = append(got, ionoIndexes(res.GetMeasurements()))
+				if res.GetNextPageToken() == "" || len(got) > len(tt.want) {
+					break
+				}
+				tt.filter.PageToken = res.GetNextPageToken()
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetMeasurements() pages = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	for _, filter := range []*pb.SatelliteFilter{{PageSize: -1}, {PageToken: "garbage"}} {
+		if _, err := s.GetMeasurements(context.Background(), filter); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("GetMeasurements(%v) error = %v, want %v", filter, err, codes.InvalidArgument)
+		}
+	}
+}
+
+func TestGetComputationsPages(t *testing.T) {
+	s := NewV1(newTestServer(t))
+	for _, idSat := range []int{1, 2} {
+		if err := s.s.db.AddComputation(&database.Computation{IdSat: idSat}); err != nil {
+			t.Fatalf("AddComputation() error = %v", err)
+		}
+	}
+
+	first, err := s.GetComputations(context.Background(), &pb.SatelliteFilter{PageSize: 1, Descending: true})
+	if err != nil || len(first.GetComputations()) != 1 || first.GetComputations()[0].GetIdSat() != 2 || first.GetNextPageToken() == "" {
+		t.Fatalf("GetComputations() = %v, %v, want computation of satellite 2 and a next page", first, err)
+	}
+	last, err := s.GetComputations(context.Background(), &pb.SatelliteFilter{PageSize: 1, Descending: true, PageToken: first.GetNextPageToken()})
+	if err != nil || len(last.GetComputations()) != 1 || last.GetComputations()[0].GetIdSat() != 1 || last.GetNextPageToken() != "" {
+		t.Errorf("GetComputations() = %v, %v, want computation of satellite 1 on the last page", last, err)
+	}
+}
+
+func TestGetMeasurementsPagesREST(t *testing.T) {
+	mux := NewGatewayMux()
+	if err := pb.RegisterSatelliteCommunicationHandlerServer(context.Background(), mux, NewV1(newTestServer(t))); err != nil {
+		t.Fatalf("RegisterSatelliteCommunicationHandlerServer() error = %v", err)
+	}
+
+	var res struct {
+		Measurements []struct {
+			IonoIndex float32 `json:"ionoIndex"`
+		} `json:"measurements"`
+		NextPageToken string `json:"next_page_token"`
+	}
+	get := func(url string) {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, url, nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("GET %s status = %d: %s", url, rec.Code, rec.Body)
+		}
+		res.NextPageToken = ""
+		if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+			t.Fatalf("Error decoding response, %v", err)
+		}
+	}
+
+	get("/v1/measurements/1?page_size=2&descending=true")
+	if len(res.Measurements) != 2 || res.Measurements[0].IonoIndex != 30 || res.NextPageToken == "" {
+		t.Fatalf("first page = %+v, want 2 measurements from the latest and a next page token", res)
+	}
+	get("/v1/measurements/1?pageSize=2&descending=true&pageToken=" + res.NextPageToken)
+	if len(res.Measurements) != 1 || res.Measurements[0].IonoIndex != 10 || res.NextPageToken != "" {
+		t.Errorf("last page = %+v, want the earliest measurement and no next page token", res)
+	}
+}
+
+// dial serves s and its v1 API on an in-memory listener and returns a connection to it.
+func dial(t *testing.T, s *Server) *grpc.ClientConn {
+	lis := bufconn.Listen(1 << 20)
+	gs := grpc.NewServer()
+	pb.RegisterSatelliteCommunicationServer(gs, NewV1(s))
+	pbv2.RegisterSatelliteCommunicationServer(gs, s)
+	go gs.Serve(lis)
+	t.Cleanup(gs.Stop)
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Error dialing server, %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestStreamMeasurements(t *testing.T) {
+	client := pb.NewSatelliteCommunicationClient(dial(t, newTestServer(t)))
+
+	tests := []struct {
+		name     string
+		filter   *pb.TimestampFilter
+		want     []float32
+		wantCode codes.Code
+	}{
+		{"satellite", &pb.TimestampFilter{SatId: 1}, []float32{10, 21, 30}, codes.OK},
+		{"range descending", &pb.TimestampFilter{StartTime: timestamppb.New(at(15)), Descending: true}, []float32{30, 21, 20}, codes.OK},
+		{"start after end", &pb.TimestampFilter{StartTime: timestamppb.New(at(30)), EndTime: timestamppb.New(at(20))}, []float32{}, codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream, err := client.StreamMeasurements(context.Background(), tt.filter)
+			if err != nil {
+				t.Fatalf("StreamMeasurements() error = %v", err)
+			}
+			got := make([]float32, 0)
+			for {
+				m, err := stream.Recv()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					if code := status.Code(err); code != tt.wantCode {
+						t.Fatalf("Recv() code = %v, want %v (%v)", code, tt.wantCode, err)
+					}
+					break
+				}
+				got = append(got, m.GetIonoIndex())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("StreamMeasurements() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// cancellingStream cancels its context after n measurements were sent.
+type cancellingStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	n      int
+	sent   int
+}
+
+func (s *cancellingStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *cancellingStream) Send(m *pbv2.Measurement) error {
+	s.sent++
+	if s.sent == s.n {
+		s.cancel()
+	}
+	return nil
+}
+
+func TestStreamMeasurementsCancel(t *testing.T) {
+	store := memory.New()
+	if err := store.AddSatellite(&database.Satellite{Name: "30J14"}); err != nil {
+		t.Fatalf("AddSatellite() error = %v", err)
+	}
+	ms := make([]*database.Measurement, 0, 3*StreamBatchSize)
+	for i := 0; i < 3*StreamBatchSize; i++ {
+		ms = append(ms, &database.Measurement{IdSat: 1, Timestamp: at(0).Add(time.Duration(i) * time.Second)})
+	}
+	if err := store.BulkAddMeasurements(ms); err != nil {
+		t.Fatalf("BulkAddMeasurements() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &cancellingStream{ctx: ctx, cancel: cancel, n: 10}
+	err := New(store, nil).StreamMeasurements(&pbv2.TimestampFilter{SatId: 1}, stream)
+	if status.Code(err) != codes.Canceled {
+		t.Errorf("StreamMeasurements() error = %v, want %v", err, codes.Canceled)
+	}
+	if stream.sent != StreamBatchSize {
+		t.Errorf("StreamMeasurements() sent %d measurements after cancel, want to stop after the batch of %d", stream.sent, StreamBatchSize)
+	}
+}
+
+func TestStreamMeasurementsREST(t *testing.T) {
+	mux := NewGatewayMux()
+	if err := pb.RegisterSatelliteCommunicationHandler(context.Background(), mux, dial(t, newTestServer(t))); err != nil {
+		t.Fatalf("RegisterSatelliteCommunicationHandler() error = %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/measurements/1/stream?endTime=2016-02-20T15:30:00Z", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET status = %d: %s", rec.Code, rec.Body)
+	}
+
+	got := make([]float32, 0)
+	dec := json.NewDecoder(rec.Body)
+	for dec.More() {
+		var line struct {
+			Result struct {
+				IonoIndex float32 `json:"ionoIndex"`
+			} `json:"result"`
+		}
+		if err := dec.Decode(&line); err != nil {
+			t.Fatalf("Error decoding line, %v", err)
+		}
+		got = append(got, line.Result.IonoIndex)
+	}
+	if want := []float32{10, 21}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GET stream = %v, want %v", got, want)
+	}
+}
+
+func TestUploadMeasurements(t *testing.T) {
+	s := NewV1(newTestServer(t))
+	client := pb.NewSatelliteCommunicationClient(dial(t, s.s))
+
+	stream, err := client.UploadMeasurements(context.Background())
+	if err != nil {
+		t.Fatalf("UploadMeasurements() error = %v", err)
+	}
+	for _, rq := range []*pb.UploadRequest{
+		{Measurement: &pb.Measurement{FileName: "up.csv", IdSat: 2, Timestamp: timestamppb.New(at(40)), IonoIndex: 40}},
+		{Measurement: &pb.Measurement{FileName: "up.csv", Timestamp: timestamppb.New(at(41)), IonoIndex: 41}, Satellite: "30J14"},
+		{Measurement: &pb.Measurement{FileName: "up.csv", IdSat: 9, Timestamp: timestamppb.New(at(42))}},
+		{Measurement: &pb.Measurement{FileName: "up.csv", Timestamp: timestamppb.New(at(43))}, Satellite: "6N14"},
+		{Measurement: &pb.Measurement{FileName: "up.csv", IdSat: 1}},
+		{Measurement: &pb.Measurement{FileName: "up.csv", Timestamp: timestamppb.New(at(45))}},
+		{},
+	} {
+		if err := stream.Send(rq); err != nil {
+			t.Fatalf("Send() error = %v", err)
+		}
+	}
+	summary, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Fatalf("CloseAndRecv() error = %v", err)
+	}
+
+	if summary.GetAccepted() != 2 || summary.GetRunId() == 0 {
+		t.Errorf("UploadMeasurements() = %v, want 2 accepted and a run id", summary)
+	}
+	wantRejected := map[int32]string{
+		2: "satellite 9 does not exist",
+		3: `satellite "6N14" does not exist`,
+		4: "timestamp is missing",
+		5: "satellite is missing",
+		6: "measurement is missing",
+	}
+	gotRejected := make(map[int32]string)
+	for _, r := range summary.GetRejected() {
+		gotRejected[r.GetIndex()] = r.GetReason()
+	}
+	if !reflect.DeepEqual(gotRejected, wantRejected) {
+		t.Errorf("UploadMeasurements() rejected = %v, want %v", gotRejected, wantRejected)
+	}
+
+	got, err := s.GetMeasurementsBetween(context.Background(), &pb.TimestampFilter{StartTime: timestamppb.New(at(40))})
+	if err != nil {
+		t.Fatalf("GetMeasurementsBetween() error = %v", err)
+	}
+	if values := ionoIndexes(got.GetMeasurements()); !reflect.DeepEqual(values, []float32{40, 41}) {
+		t.Errorf("GetMeasurementsBetween() after upload = %v, want [40 41]", values)
+	}
+}
+
+// endlessUpload sends measurements until the server stops reading.
+type endlessUpload struct {
+	grpc.ServerStream
+	received int
+}
+
+func (s *endlessUpload) Recv() (*pbv2.UploadRequest, error) {
+	s.received++
+	return &pbv2.UploadRequest{}, nil
+}
+
+func (s *endlessUpload) SendAndClose(*pbv2.UploadSummary) error {
+	return nil
+}
+
+func TestUploadMeasurementsLimit(t *testing.T) {
+	stream := &endlessUpload{}
+	err := newTestServer(t).UploadMeasurements(stream)
+	if status.Code(err) != codes.ResourceExhausted || stream.received != MaxUploadMeasurements+1 {
+		t.Errorf("UploadMeasurements() = %v after %d measurements, want %v after %d", err, stream.received, codes.ResourceExhausted, MaxUploadMeasurements+1)
+	}
+}
+
+func TestWatchMeasurements(t *testing.T) {
+	s := NewV1(newTestServer(t))
+	client := pb.NewSatelliteCommunicationClient(dial(t, s.s))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// resumes after the first measurement of satellite 1, ids 2 and 4 follow
+	stream, err := client.WatchMeasurements(ctx, &pb.WatchFilter{SatId: 1, AfterId: 1})
+	if err != nil {
+		t.Fatalf("WatchMeasurements() error = %v", err)
+	}
+
+	var got []float32
+	for len(got) < 2 {
+		m, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv() error = %v", err)
+		}
+		got = append(got, m.GetIonoIndex())
+	}
+	for _, m := range []*pb.Measurement{
+		{FileName: "b.csv", IdSat: 2, Timestamp: timestamppb.New(at(40)), IonoIndex: 40},
+		{FileName: "b.csv", IdSat: 1, Timestamp: timestamppb.New(at(41)), IonoIndex: 41},
+	} {
+		if _, err := s.AddMeasurement(context.Background(), m); err != nil {
+			t.Fatalf("AddMeasurement() error = %v", err)
+		}
+	}
+	m, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv() error = %v", err)
+	}
+	got = append(got, m.GetIonoIndex())
+	if want := []float32{10, 21, 41}; !reflect.DeepEqual(got, want) {
+		t.Errorf("WatchMeasurements() = %v, want %v", got, want)
+	}
+
+	cancel()
+	if _, err := stream.Recv(); status.Code(err) != codes.Canceled {
+		t.Errorf("Recv() after cancel error = %v, want %v", err, codes.Canceled)
+	}
+}
+
+func TestComputeStatistics(t *testing.T) {
+	s := NewV1(newTestServer(t))
+
+	tests := []struct {
+		name     string
+		rq       *pb.ComputeStatisticsRequest
+		want     *pb.Computation
+		wantCode codes.Code
+	}{
+		{"all", &pb.ComputeStatisticsRequest{SatId: 1},
+			&pb.Computation{IdSat: 1, Duration: durationpb.New(20 * time.Minute), MinIono: 10, MaxIono: 30, AvgIono: float32(61.0 / 3)}, codes.OK},
+		{"range", &pb.ComputeStatisticsRequest{SatId: 1, StartTime: timestamppb.New(at(20)), EndTime: timestamppb.New(at(30))},
+			&pb.Computation{IdSat: 1, Duration: durationpb.New(0), MinIono: 21, MaxIono: 21, AvgIono: 21}, codes.OK},
+		{"selected", &pb.ComputeStatisticsRequest{SatId: 1, Statistics: []pb.Statistic{pb.Statistic_DURATION, pb.Statistic_NDVI}},
+			&pb.Computation{IdSat: 1, Duration: durationpb.New(20 * time.Minute)}, codes.OK},
+		{"no satellite", &pb.ComputeStatisticsRequest{}, nil, codes.InvalidArgument},
+		{"unknown statistic", &pb.ComputeStatisticsRequest{SatId: 1, Statistics: []pb.Statistic{42}}, nil, codes.InvalidArgument},
+		{"unknown satellite", &pb.ComputeStatisticsRequest{SatId: 9}, nil, codes.NotFound},
+		{"no measurements", &pb.ComputeStatisticsRequest{SatId: 1, StartTime: timestamppb.New(at(40))}, nil, codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.ComputeStatistics(context.Background(), tt.rq)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Fatalf("ComputeStatistics() code = %v, want %v (%v)", code, tt.wantCode, err)
+			}
+			if err != nil {
+				return
+			}
+			if !proto.Equal(got.GetComputation(), tt.want) {
+				t.Errorf("ComputeStatistics() = %v, want %v", got.GetComputation(), tt.want)
+			}
+		})
+	}
+
+	// persisted computations are stored with an id and version
+	got, err := s.ComputeStatistics(context.Background(), &pb.ComputeStatisticsRequest{SatId: 2, Persist: true})
+	if err != nil {
+		t.Fatalf("ComputeStatistics() persist error = %v", err)
+	}
+	if got.GetMeasurements() != 1 || got.GetComputation().GetId() == 0 || got.GetComputation().GetVersion() != 1 {
+		t.Errorf("ComputeStatistics() persist = %v, want a stored computation of 1 measurement", got)
+	}
+	stored, err := s.GetComputations(context.Background(), &pb.SatelliteFilter{SatId: 2})
+	if err != nil || len(stored.GetComputations()) != 1 || !proto.Equal(stored.GetComputations()[0], got.GetComputation()) {
+		t.Errorf("GetComputations() = %v, %v, want %v", stored.GetComputations(), err, got.GetComputation())
+	}
+}
+
+func TestComputeStatisticsRejected(t *testing.T) {
+	srv := newTestServer(t)
+	if err := srv.db.SoftDeleteSatellite(2); err != nil {
+		t.Fatalf("SoftDeleteSatellite() error = %v", err)
+	}
+	// satellite 1 has 3 measurements already
+	ms := make([]*database.Measurement, 0, MaxStatisticsMeasurements)
+	for i := 0; i < MaxStatisticsMeasurements-2; i++ {
+		ms = append(ms, &database.Measurement{FileName: "big.csv", IdSat: 1, Timestamp: at(40)})
+	}
+	if err := srv.db.BulkAddMeasurements(ms); err != nil {
+		t.Fatalf("BulkAddMeasurements() error = %v", err)
+	}
+
+	for satId, want := range map[int32]codes.Code{1: codes.ResourceExhausted, 2: codes.FailedPrecondition} {
+		_, err := srv.ComputeStatistics(context.Background(), &pbv2.ComputeStatisticsRequest{SatId: satId, Persist: true})
+		if status.Code(err) != want {
+			t.Errorf("ComputeStatistics(%d) error = %v, want %v", satId, err, want)
+		}
+	}
+}
+
+func TestComputeStatisticsPersistIngested(t *testing.T) {
+	srv := newTestServer(t)
+	rows, err := csv.ReadCsvFile("../csv/fixtures/happypath.csv")
+	if err != nil {
+		t.Fatalf("ReadCsvFile() error = %v", err)
+	}
+	sats, err := csv.ParseCsvData(rows)
+	if err != nil {
+		t.Fatalf("ParseCsvData() error = %v", err)
+	}
+	for _, sat := range sats {
+		sat.MeasurementTime()
+		sat.Compute()
+	}
+	if _, err := srv.db.Ingest("happypath.csv", sats); err != nil {
+		t.Fatalf("Ingest() error = %v", err)
+	}
+
+	// the ingested computation of satellite 1 is replaced by the one of all
+	// its measurements, with every statistic though only iono is selected
+	got, err := srv.ComputeStatistics(context.Background(), &pbv2.ComputeStatisticsRequest{
+		SatId: 1, Persist: true, Statistics: []pbv2.Statistic{pbv2.Statistic_STATISTIC_IONO},
+	})
+	if err != nil {
+		t.Fatalf("ComputeStatistics() error = %v", err)
+	}
+	if c := got.GetComputation(); c.GetVersion() != 2 || c.GetMaxIono() != 30 || c.GetMaxNdvi() != 0 {
+		t.Errorf("ComputeStatistics() = %v, want version 2 with only iono statistics", c)
+	}
+	stored, err := srv.db.GetComputations(1)
+	if err != nil || len(stored) != 1 || stored[0].Version != 2 || stored[0].MaxIono != 30 || stored[0].MaxNdvi != 33 {
+		t.Errorf("GetComputations() = %v, %v, want one computation at version 2 with every statistic", stored, err)
+	}
+}
+
+// multipartFile returns a multipart form with the file content in its part
+// field.
+func multipartFile(t *testing.T, field, content string) (io.Reader, string) {
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, err := form.CreateFormFile(field, "upload.csv")
+	if err != nil {
+		t.Fatalf("CreateFormFile() error = %v", err)
+	}
+	io.WriteString(part, content)
+	form.Close()
+	return &body, form.FormDataContentType()
+}
+
+func TestIngestREST(t *testing.T) {
+	s := NewV1(newTestServer(t))
+	mux := NewGatewayMux()
+	handler := V1IngestHandler(mux, pb.NewSatelliteCommunicationClient(dial(t, s.s)))
+	happy, err := os.ReadFile("../csv/fixtures/happypath.csv")
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	invalid, err := os.ReadFile("../csv/fixtures/invalidFile.csv")
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		method     string
+		field      string
+		content    string
+		wantStatus int
+		want       string
+	}{
+		{"ingested", http.MethodPost, "file", string(happy), http.StatusOK, `"fileName":"upload.csv","satellites":1,"measurements":5,"computations":3`},
+		{"invalid file", http.MethodPost, "file", string(invalid), http.StatusBadRequest, "parsing"},
+		{"empty file", http.MethodPost, "file", "", http.StatusBadRequest, "no satellites"},
+		{"no file part", http.MethodPost, "data", string(happy), http.StatusBadRequest, "no file part"},
+		{"not posted", http.MethodGet, "file", string(happy), http.StatusMethodNotAllowed, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, contentType := multipartFile(t, tt.field, tt.content)
+			rq := httptest.NewRequest(tt.method, "/v1/ingest", body)
+			rq.Header.Set("Content-Type", contentType)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, rq)
+			if rec.Code != tt.wantStatus || !strings.Contains(rec.Body.String(), tt.want) {
+				t.Errorf("%s /ingest = %d %s, want %d %q", tt.method, rec.Code, rec.Body.String(), tt.wantStatus, tt.want)
+			}
+		})
+	}
+
+	// the ingested file is stored like one read by satelliteApp
+	got, err := s.GetSatellite(context.Background(), &pb.GetSatelliteRequest{Name: "6N14"})
+	if err != nil || got.GetSatType() != "Ss" {
+		t.Errorf("GetSatellite() = %v, %v, want the ingested Ss satellite", got, err)
+	}
+
+	// files with soft deleted satellites are rejected
+	if err := s.s.db.SoftDeleteSatellite(int(got.GetId())); err != nil {
+		t.Fatalf("SoftDeleteSatellite() error = %v", err)
+	}
+	body, contentType := multipartFile(t, "file", string(happy))
+	rq := httptest.NewRequest(http.MethodPost, "/v1/ingest", body)
+	rq.Header.Set("Content-Type", contentType)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, rq)
+	if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), `6N14.name: satellite \"6N14\" is deleted`) {
+		t.Errorf("POST /ingest deleted satellite = %d %s, want %d", rec.Code, rec.Body.String(), http.StatusBadRequest)
+	}
+}
+
+func TestSatellitesREST(t *testing.T) {
+	mux := NewGatewayMux()
+	if err := pb.RegisterSatelliteCommunicationHandlerServer(context.Background(), mux, NewV1(newTestServer(t))); err != nil {
+		t.Fatalf("RegisterSatelliteCommunicationHandlerServer() error = %v", err)
+	}
+
+	// the requests run in order, each on the state left by the previous ones
+	steps := []struct {
+		method     string
+		url        string
+		body       string
+		wantStatus int
+		want       string
+		wantNot    string
+	}{
+		{http.MethodGet, "/v1/satellites/name/8J14", "", http.StatusOK, `"id":2`, ""},
+		{http.MethodPatch, "/v1/satellites/2", `{"name":"8J15"}`, http.StatusOK, `"name":"8J15"`, ""},
+		{http.MethodPatch, "/v1/satellites/2", `{"name":"30J14"}`, http.StatusConflict, "", ""},
+		{http.MethodPatch, "/v1/satellites/9", `{"name":"6N14"}`, http.StatusNotFound, "", ""},
+		{http.MethodPatch, "/v1/satellites/2", `{"satType":"Xx"}`, http.StatusBadRequest, "", ""},
+		{http.MethodPatch, "/v1/satellites/2", `{"status":"lost"}`, http.StatusBadRequest, "", ""},
+		{http.MethodPatch, "/v1/satellites/2", `{"mission":"Sentinel","launchDate":"2014-03-02T10:00:00Z","tags":["ndvi","iono"]}`, http.StatusOK,
+			`"name":"8J15","mission":"Sentinel","launchDate":"2014-03-02T00:00:00Z","status":"active","tags":["iono","ndvi"]`, ""},
+		{http.MethodGet, "/v1/satellites?tag=ndvi", "", http.StatusOK, "8J15", "30J14"},
+		{http.MethodGet, "/v1/satellites?mission=Landsat", "", http.StatusOK, `"satellites":[]`, "8J15"},
+		{http.MethodGet, "/v1/satellites?launched_before=2014-03-02T00:00:00Z", "", http.StatusOK, "", "8J15"},
+		{http.MethodGet, "/v1/satellites?launched_after=2014-03-02T00:00:00Z", "", http.StatusOK, "8J15", ""},
+		{http.MethodDelete, "/v1/satellites/1", "", http.StatusBadRequest, "measurements", ""},
+		{http.MethodDelete, "/v1/satellites/1?soft=true", "", http.StatusOK, "", ""},
+		{http.MethodGet, "/v1/satellites", "", http.StatusOK, "8J15", "30J14"},
+		{http.MethodGet, "/v1/satellites?show_deleted=true&page_size=1", "", http.StatusOK, `"deleted"`, "8J15"},
+		{http.MethodGet, "/v1/satellites/1", "", http.StatusOK, `"deleted"`, ""},
+		{http.MethodDelete, "/v1/satellites/1?cascade=true", "", http.StatusOK, "", ""},
+		{http.MethodGet, "/v1/satellites/1", "", http.StatusNotFound, "", ""},
+	}
+
+	for _, step := range steps {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(step.method, step.url, strings.NewReader(step.body)))
+		body := rec.Body.String()
+		if rec.Code != step.wantStatus {
+			t.Fatalf("%s %s status = %d, want %d: %s", step.method, step.url, rec.Code, step.wantStatus, body)
+		}
+		if !strings.Contains(body, step.want) || step.wantNot != "" && strings.Contains(body, step.wantNot) {
+			t.Errorf("%s %s = %s, want %q and not %q", step.method, step.url, body, step.want, step.wantNot)
+		}
+	}
+}
+
+func TestUpdateAndDeleteREST(t *testing.T) {
+	s := NewV1(newTestServer(t))
+	if _, err := s.AddComputation(context.Background(), &pb.Computation{IdSat: 1, MaxIono: 30}); err != nil {
+		t.Fatalf("AddComputation() error = %v", err)
+	}
+	mux := NewGatewayMux()
+	if err := pb.RegisterSatelliteCommunicationHandlerServer(context.Background(), mux, s); err != nil {
+		t.Fatalf("RegisterSatelliteCommunicationHandlerServer() error = %v", err)
+	}
+
+	// the requests run in order, each on the state left by the previous ones
+	steps := []struct {
+		method     string
+		url        string
+		body       string
+		wantStatus int
+		want       string
+	}{
+		{http.MethodPatch, "/v1/measurements/1", `{"ionoIndex": 31, "version": 1}`, http.StatusOK, `"fileName":"a.csv","idSat":1,"ionoIndex":31,"timestamp":"2016-02-20T15:30:00Z","version":2`},
+		{http.MethodPatch, "/v1/measurements/1", `{"ionoIndex": 32, "version": 1}`, http.StatusConflict, ""},
+		{http.MethodPatch, "/v1/measurements/1", `{"version": 2}`, http.StatusBadRequest, ""},
+		{http.MethodPatch, "/v1/measurements/1", `{"idSat": 9}`, http.StatusBadRequest, ""},
+		{http.MethodPatch, "/v1/measurements/9", `{"ionoIndex": 1}`, http.StatusNotFound, ""},
+		{http.MethodGet, "/v1/measurements/1", "", http.StatusOK, `"ionoIndex":31`},
+		{http.MethodDelete, "/v1/measurements/1?version=1", "", http.StatusConflict, ""},
+		{http.MethodDelete, "/v1/measurements/1?version=2", "", http.StatusOK, ""},
+		{http.MethodDelete, "/v1/measurements/1", "", http.StatusNotFound, ""},
+		{http.MethodPatch, "/v1/computations/1", `{"duration": "3600s"}`, http.StatusOK, `"duration":"3600s","version":2`},
+		{http.MethodGet, "/v1/computations/1", "", http.StatusOK, `"maxIono":30`},
+		{http.MethodDelete, "/v1/computations/1", "", http.StatusOK, ""},
+	}
+
+	for _, step := range steps {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(step.method, step.url, strings.NewReader(step.body)))
+		body := rec.Body.String()
+		if rec.Code != step.wantStatus {
+			t.Fatalf("%s %s %s status = %d, want %d: %s", step.method, step.url, step.body, rec.Code, step.wantStatus, body)
+		}
+		if !strings.Contains(body, step.want) {
+			t.Errorf("%s %s %s = %s, want %q", step.method, step.url, step.body, body, step.want)
+		}
+	}
+}
+
+func TestV2REST(t *testing.T) {
+	mux := NewGatewayMux()
+	if err := pbv2.RegisterSatelliteCommunicationHandlerServer(context.Background(), mux, newTestServer(t)); err != nil {
+		t.Fatalf("RegisterSatelliteCommunicationHandlerServer() error = %v", err)
+	}
+
+	// the requests run in order, each on the state left by the previous ones
+	steps := []struct {
+		method     string
+		url        string
+		body       string
+		wantStatus int
+		want       string
+		wantNot    string
+	}{
+		{http.MethodPatch, "/v2/satellites/1", `{"type":"SATELLITE_TYPE_EA"}`, http.StatusOK, `"name":"30J14","type":"SATELLITE_TYPE_EA"`, ""},
+		{http.MethodPatch, "/v2/satellites/1", `{"type":"SATELLITE_TYPE_XX"}`, http.StatusBadRequest, "", ""},
+		{http.MethodGet, "/v2/satellites?type=SATELLITE_TYPE_EA", "", http.StatusOK, "30J14", "8J14"},
+		{http.MethodPost, "/v2/measurements", `{"file_name":"b.csv","sat_id":1,"timestamp":"2016-02-20T15:40:00Z","altitude":350.5}`, http.StatusOK,
+			`"file_name":"b.csv","sat_id":1,"timestamp":"2016-02-20T15:40:00Z","altitude":350.5`, ""},
+		{http.MethodPatch, "/v2/measurements/5", `{"iono_index":7}`, http.StatusOK, `"iono_index":7,"altitude":350.5,"version":2`, ""},
+		{http.MethodGet, "/v2/measurements/1/between?start_time=2016-02-20T15:35:00Z", "", http.StatusOK, `"altitude":350.5`, `"other"`},
+		{http.MethodGet, "/v2/measurements/2", "", http.StatusOK, `"sat_id":2`, `"altitude"`},
+		{http.MethodGet, "/v2/measurements/9", "", http.StatusOK, `"measurements":[]`, ""},
+		{http.MethodGet, "/v2/satellites?mission=none", "", http.StatusOK, `"satellites":[]`, ""},
+		{http.MethodGet, "/v2/computations/1?page_token=x", "", http.StatusBadRequest, `"field":"page_token"`, ""},
+		{http.MethodGet, "/v2/satellites/9", "", http.StatusNotFound, `"resource_type":"satellites","resource_name":"9"`, ""},
+	}
+
+	for _, step := range steps {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(step.method, step.url, strings.NewReader(step.body)))
+		body := rec.Body.String()
+		if rec.Code != step.wantStatus {
+			t.Fatalf("%s %s status = %d, want %d: %s", step.method, step.url, rec.Code, step.wantStatus, body)
+		}
+		if !strings.Contains(body, step.want) || step.wantNot != "" && strings.Contains(body, step.wantNot) {
+			t.Errorf("%s %s = %s, want %q and not %q", step.method, step.url, body, step.want, step.wantNot)
+		}
+	}
+}
+
+func TestV1Measurement(t *testing.T) {
+	tests := []struct {
+		name string
+		m    *pbv2.Measurement
+		want string
+	}{
+		{"altitude", &pbv2.Measurement{Specific: &pbv2.Measurement_Altitude{Altitude: 350.5}}, "350.5"},
+		{"sea salinity", &pbv2.Measurement{Specific: &pbv2.Measurement_SeaSalinity{SeaSalinity: 35}}, "35"},
+		{"vegetation", &pbv2.Measurement{Specific: &pbv2.Measurement_Vegetation{Vegetation: "forest"}}, "forest"},
+		{"other", &pbv2.Measurement{Specific: &pbv2.Measurement_Other{Other: "china"}}, "china"},
+		{"unset", &pbv2.Measurement{}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := v1Measurement(tt.m)
+			if got.GetSpecificMeasurement() != tt.want {
+				t.Errorf("v1Measurement() specificMeasurement = %q, want %q", got.GetSpecificMeasurement(), tt.want)
+			}
+		})
+	}
+}
+
+func TestV2Mask(t *testing.T) {
+	mask := &fieldmaskpb.FieldMask{Paths: []string{"idSat", "ionoIndex", "specificMeasurement", "satType", "launchDate", "maxIono", "name"}}
+	want := []string{"sat_id", "iono_index", "other", "type", "launch_date", "max_iono", "name"}
+	if got := v2Mask(mask).GetPaths(); !reflect.DeepEqual(got, want) {
+		t.Errorf("v2Mask() = %v, want %v", got, want)
+	}
+}
+
+func TestStoreError(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		wantCode     codes.Code
+		wantResource string
+		wantField    string
+	}{
+		{"not found", database.NotFound("satellites", 3, errors.New("satellite 3 does not exist")), codes.NotFound, "satellites", ""},
+		{"duplicate", &database.Error{Kind: database.ErrDuplicate, Err: errors.New("satellite 30J14 exists")}, codes.AlreadyExists, "", ""},
+		{"foreign key", &database.Error{Kind: database.ErrForeignKey, Err: errors.New("satellite 3 has measurements")}, codes.FailedPrecondition, "", ""},
+		{"version conflict", &database.Error{Kind: database.ErrVersionConflict, Err: errors.New("row 1 is not at version 2"), Resource: "measurements", Key: "1"}, codes.Aborted, "measurements", ""},
+		{"unavailable", fmt.Errorf("Error executing sql query: %w", driver.ErrBadConn), codes.Unavailable, "", ""},
+		{"invalid", &database.Error{Kind: database.ErrInvalid, Err: errors.New("NOT NULL constraint failed")}, codes.InvalidArgument, "", ""},
+		{"page token", database.ErrInvalidPageToken, codes.InvalidArgument, "", "page_token"},
+		{"unknown", errors.New("disk full"), codes.Internal, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := status.Convert(storeError(tt.err, "Cannot read"))
+			if st.Code() != tt.wantCode {
+				t.Errorf("storeError() code = %v, want %v", st.Code(), tt.wantCode)
+			}
+			var resource, field string
+			for _, detail := range st.Details() {
+				switch detail := detail.(type) {
+				case *errdetails.ResourceInfo:
+					resource = detail.GetResourceType()
+				case *errdetails.BadRequest:
+					field = detail.GetFieldViolations()[0].GetField()
+				}
+			}
+			if resource != tt.wantResource || field != tt.wantField {
+				t.Errorf("storeError() details resource = %q, field = %q, want %q, %q", resource, field, tt.wantResource, tt.wantField)
+			}
+		})
+	}
+}
+
+func TestRequestValidation(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	tests := []struct {
+		name       string
+		call       func() error
+		wantFields []string
+	}{
+		{"satellite without name", func() error {
+			_, err := s.AddSatellite(ctx, &pbv2.Satellite{Mission: "ESA"})
+			return err
+		}, []string{"name"}},
+		{"satellite of unknown type", func() error {
+			_, err := s.AddSatellite(ctx, &pbv2.Satellite{Name: "9J14", Type: 7})
+			return err
+		}, []string{"type"}},
+		{"measurement of unknown satellite", func() error {
+			_, err := s.AddMeasurement(ctx, &pbv2.Measurement{FileName: "b.csv", SatId: 9, Timestamp: timestamppb.New(at(40))})
+			return err
+		}, []string{"sat_id"}},
+		{"measurement of invalid values", func() error {
+			_, err := s.AddMeasurement(ctx, &pbv2.Measurement{FileName: "b.csv", SatId: 1, IonoIndex: -1, NdviIndex: 150})
+			return err
+		}, []string{"timestamp", "iono_index", "ndvi_index"}},
+		{"measurement before the first satellite", func() error {
+			_, err := s.AddMeasurement(ctx, &pbv2.Measurement{FileName: "b.csv", SatId: 1, Timestamp: timestamppb.New(time.Date(1950, 1, 1, 0, 0, 0, 0, time.UTC))})
+			return err
+		}, []string{"timestamp"}},
+		{"measurement update of masked field", func() error {
+			_, err := s.UpdateMeasurement(ctx, &pbv2.UpdateMeasurementRequest{
+				Measurement: &pbv2.Measurement{Id: 1, IonoIndex: -1, Specific: &pbv2.Measurement_Altitude{Altitude: -5}},
+				UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"altitude"}},
+			})
+			return err
+		}, []string{"measurement.altitude"}},
+		{"computation of negative duration", func() error {
+			_, err := s.AddComputation(ctx, &pbv2.Computation{SatId: 1, Duration: durationpb.New(-time.Minute)})
+			return err
+		}, []string{"duration"}},
+		{"delete without id", func() error {
+			_, err := s.DeleteMeasurement(ctx, &pbv2.DeleteRequest{})
+			return err
+		}, []string{"id"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := status.Convert(tt.call())
+			if st.Code() != codes.InvalidArgument {
+				t.Fatalf("error code = %v, want %v: %v", st.Code(), codes.InvalidArgument, st.Message())
+			}
+			var fields []string
+			for _, detail := range st.Details() {
+				if br, ok := detail.(*errdetails.BadRequest); ok {
+					for _, v := range br.GetFieldViolations() {
+						fields = append(fields, v.GetField())
+					}
+				}
+			}
+			if !reflect.DeepEqual(fields, tt.wantFields) {
+				t.Errorf("violations of fields %v, want %v", fields, tt.wantFields)
+			}
+		})
+	}
+}
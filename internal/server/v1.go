@@ -0,0 +1,469 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/Simek13/satelliteApp/internal/database"
+	pbv1 "github.com/Simek13/satelliteApp/pkg/v1"
+	pb "github.com/Simek13/satelliteApp/pkg/v2"
+)
+
+// V1Server serves the v1 API by converting its messages to and from those of
+// the v2 API served by Server.
+type V1Server struct {
+	pbv1.UnimplementedSatelliteCommunicationServer
+
+	s *Server
+}
+
+func NewV1(s *Server) *V1Server {
+	return &V1Server{s: s}
+}
+
+func (v *V1Server) GetMeasurements(ctx context.Context, filter *pbv1.SatelliteFilter) (*pbv1.MeasurementResponse, error) {
+	r, err := v.s.GetMeasurements(ctx, v2SatelliteFilter(filter))
+	if err != nil {
+		return nil, err
+	}
+	return v1MeasurementResponse(r), nil
+}
+
+func (v *V1Server) GetMeasurementsBetween(ctx context.Context, filter *pbv1.TimestampFilter) (*pbv1.MeasurementResponse, error) {
+	r, err := v.s.GetMeasurementsBetween(ctx, v2TimestampFilter(filter))
+	if err != nil {
+		return nil, err
+	}
+	return v1MeasurementResponse(r), nil
+}
+
+func (v *V1Server) StreamMeasurements(filter *pbv1.TimestampFilter, stream pbv1.SatelliteCommunication_StreamMeasurementsServer) error {
+	return v.s.StreamMeasurements(v2TimestampFilter(filter), v1StreamMeasurements{stream})
+}
+
+func (v *V1Server) WatchMeasurements(filter *pbv1.WatchFilter, stream pbv1.SatelliteCommunication_WatchMeasurementsServer) error {
+	f := &pb.WatchFilter{SatId: filter.GetSatId(), FileName: filter.GetFileName(), AfterId: filter.GetAfterId()}
+	return v.s.WatchMeasurements(f, v1WatchMeasurements{stream})
+}
+
+func (v *V1Server) GetComputations(ctx context.Context, filter *pbv1.SatelliteFilter) (*pbv1.ComputationResponse, error) {
+	r, err := v.s.GetComputations(ctx, v2SatelliteFilter(filter))
+	if err != nil {
+		return nil, err
+	}
+	computations := make([]*pbv1.Computation, 0, len(r.GetComputations()))
+	for _, c := range r.GetComputations() {
+		computations = append(computations, v1Computation(c))
+	}
+	return &pbv1.ComputationResponse{Computations: computations, NextPageToken: r.GetNextPageToken()}, nil
+}
+
+func (v *V1Server) AddSatellite(ctx context.Context, rq *pbv1.Satellite) (*pbv1.Satellite, error) {
+	sat, err := v2Satellite(rq)
+	if err != nil {
+		return nil, err
+	}
+	return v1Result(v.s.AddSatellite(ctx, sat))
+}
+
+func (v *V1Server) ListSatellites(ctx context.Context, rq *pbv1.ListSatellitesRequest) (*pbv1.SatelliteResponse, error) {
+	satType, err := v2SatelliteType(rq.GetSatType())
+	if err != nil {
+		return nil, err
+	}
+	r, err := v.s.ListSatellites(ctx, &pb.ListSatellitesRequest{
+		PageSize:       rq.GetPageSize(),
+		PageToken:      rq.GetPageToken(),
+		Descending:     rq.GetDescending(),
+		ShowDeleted:    rq.GetShowDeleted(),
+		Type:           satType,
+		Mission:        rq.GetMission(),
+		Operator:       rq.GetOperator(),
+		Status:         rq.GetStatus(),
+		Tag:            rq.GetTag(),
+		LaunchedAfter:  rq.GetLaunchedAfter(),
+		LaunchedBefore: rq.GetLaunchedBefore(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	sats := make([]*pbv1.Satellite, 0, len(r.GetSatellites()))
+	for _, sat := range r.GetSatellites() {
+		sats = append(sats, v1Satellite(sat))
+	}
+	return &pbv1.SatelliteResponse{Satellites: sats, NextPageToken: r.GetNextPageToken()}, nil
+}
+
+func (v *V1Server) GetSatellite(ctx context.Context, rq *pbv1.GetSatelliteRequest) (*pbv1.Satellite, error) {
+	return v1Result(v.s.GetSatellite(ctx, &pb.GetSatelliteRequest{Id: rq.GetId(), Name: rq.GetName()}))
+}
+
+func (v *V1Server) UpdateSatellite(ctx context.Context, rq *pbv1.UpdateSatelliteRequest) (*pbv1.Satellite, error) {
+	var sat *pb.Satellite
+	if rq.GetSatellite() != nil {
+		var err error
+		if sat, err = v2Satellite(rq.GetSatellite()); err != nil {
+			return nil, err
+		}
+	}
+	return v1Result(v.s.UpdateSatellite(ctx, &pb.UpdateSatelliteRequest{Satellite: sat, UpdateMask: v2Mask(rq.GetUpdateMask())}))
+}
+
+func (v *V1Server) DeleteSatellite(ctx context.Context, rq *pbv1.DeleteSatelliteRequest) (*emptypb.Empty, error) {
+	return v.s.DeleteSatellite(ctx, &pb.DeleteSatelliteRequest{Id: rq.GetId(), Cascade: rq.GetCascade(), Soft: rq.GetSoft()})
+}
+
+func (v *V1Server) AddMeasurement(ctx context.Context, rq *pbv1.Measurement) (*pbv1.Measurement, error) {
+	m, err := v.s.AddMeasurement(ctx, v2Measurement(rq))
+	if err != nil {
+		return nil, err
+	}
+	return v1Measurement(m), nil
+}
+
+func (v *V1Server) UpdateMeasurement(ctx context.Context, rq *pbv1.UpdateMeasurementRequest) (*pbv1.Measurement, error) {
+	m, err := v.s.UpdateMeasurement(ctx, &pb.UpdateMeasurementRequest{Measurement: v2Measurement(rq.GetMeasurement()), UpdateMask: v2Mask(rq.GetUpdateMask())})
+	if err != nil {
+		return nil, err
+	}
+	return v1Measurement(m), nil
+}
+
+func (v *V1Server) DeleteMeasurement(ctx context.Context, rq *pbv1.DeleteRequest) (*emptypb.Empty, error) {
+	return v.s.DeleteMeasurement(ctx, &pb.DeleteRequest{Id: rq.GetId(), Version: rq.GetVersion()})
+}
+
+func (v *V1Server) UploadMeasurements(stream pbv1.SatelliteCommunication_UploadMeasurementsServer) error {
+	return v.s.UploadMeasurements(v1UploadMeasurements{stream})
+}
+
+func (v *V1Server) AddComputation(ctx context.Context, rq *pbv1.Computation) (*pbv1.Computation, error) {
+	c, err := v.s.AddComputation(ctx, v2Computation(rq))
+	if err != nil {
+		return nil, err
+	}
+	return v1Computation(c), nil
+}
+
+func (v *V1Server) UpdateComputation(ctx context.Context, rq *pbv1.UpdateComputationRequest) (*pbv1.Computation, error) {
+	c, err := v.s.UpdateComputation(ctx, &pb.UpdateComputationRequest{Computation: v2Computation(rq.GetComputation()), UpdateMask: v2Mask(rq.GetUpdateMask())})
+	if err != nil {
+		return nil, err
+	}
+	return v1Computation(c), nil
+}
+
+func (v *V1Server) DeleteComputation(ctx context.Context, rq *pbv1.DeleteRequest) (*emptypb.Empty, error) {
+	return v.s.DeleteComputation(ctx, &pb.DeleteRequest{Id: rq.GetId(), Version: rq.GetVersion()})
+}
+
+func (v *V1Server) ComputeStatistics(ctx context.Context, rq *pbv1.ComputeStatisticsRequest) (*pbv1.ComputeStatisticsResponse, error) {
+	stats := make([]pb.Statistic, 0, len(rq.GetStatistics()))
+	for _, stat := range rq.GetStatistics() {
+		stats = append(stats, pb.Statistic(stat))
+	}
+	r, err := v.s.ComputeStatistics(ctx, &pb.ComputeStatisticsRequest{
+		SatId:      rq.GetSatId(),
+		StartTime:  rq.GetStartTime(),
+		EndTime:    rq.GetEndTime(),
+		Statistics: stats,
+		Persist:    rq.GetPersist(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pbv1.ComputeStatisticsResponse{Computation: v1Computation(r.GetComputation()), Measurements: r.GetMeasurements()}, nil
+}
+
+func (v *V1Server) IngestFile(stream pbv1.SatelliteCommunication_IngestFileServer) error {
+	return v.s.IngestFile(v1IngestFile{stream})
+}
+
+// The v1 streams are adapted to the v2 streams of Server.
+
+type v1StreamMeasurements struct {
+	pbv1.SatelliteCommunication_StreamMeasurementsServer
+}
+
+func (s v1StreamMeasurements) Send(m *pb.Measurement) error {
+	return s.SatelliteCommunication_StreamMeasurementsServer.Send(v1Measurement(m))
+}
+
+type v1WatchMeasurements struct {
+	pbv1.SatelliteCommunication_WatchMeasurementsServer
+}
+
+func (s v1WatchMeasurements) Send(m *pb.Measurement) error {
+	return s.SatelliteCommunication_WatchMeasurementsServer.Send(v1Measurement(m))
+}
+
+type v1UploadMeasurements struct {
+	pbv1.SatelliteCommunication_UploadMeasurementsServer
+}
+
+func (s v1UploadMeasurements) Recv() (*pb.UploadRequest, error) {
+	rq, err := s.SatelliteCommunication_UploadMeasurementsServer.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UploadRequest{Measurement: v2Measurement(rq.GetMeasurement()), Satellite: rq.GetSatellite()}, nil
+}
+
+func (s v1UploadMeasurements) SendAndClose(summary *pb.UploadSummary) error {
+	r := &pbv1.UploadSummary{Accepted: summary.GetAccepted(), RunId: summary.GetRunId()}
+	for _, rejection := range summary.GetRejected() {
+		r.Rejected = append(r.Rejected, &pbv1.Rejection{Index: rejection.GetIndex(), Reason: rejection.GetReason()})
+	}
+	return s.SatelliteCommunication_UploadMeasurementsServer.SendAndClose(r)
+}
+
+type v1IngestFile struct {
+	pbv1.SatelliteCommunication_IngestFileServer
+}
+
+func (s v1IngestFile) Recv() (*pb.IngestChunk, error) {
+	chunk, err := s.SatelliteCommunication_IngestFileServer.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.IngestChunk{FileName: chunk.GetFileName(), Data: chunk.GetData()}, nil
+}
+
+func (s v1IngestFile) SendAndClose(r *pb.IngestReport) error {
+	return s.SatelliteCommunication_IngestFileServer.SendAndClose(v1IngestReport(r))
+}
+
+func v1IngestReport(r *pb.IngestReport) *pbv1.IngestReport {
+	report := &pbv1.IngestReport{
+		RunId:        r.GetRunId(),
+		FileName:     r.GetFileName(),
+		Satellites:   r.GetSatellites(),
+		Measurements: r.GetMeasurements(),
+		Computations: r.GetComputations(),
+	}
+	for _, sat := range r.GetStatistics() {
+		stats := &pbv1.SatelliteStatistics{
+			Satellite: sat.GetSatellite(),
+			SatType:   database.SatelliteTypeOf(sat.GetType()),
+			Duration:  sat.GetDuration(),
+		}
+		for _, c := range sat.GetChannels() {
+			stats.Channels = append(stats.Channels, &pbv1.ChannelStatistics{Channel: c.GetChannel(), Min: c.GetMin(), Max: c.GetMax(), Avg: c.GetAvg()})
+		}
+		report.Statistics = append(report.Statistics, stats)
+	}
+	for _, note := range r.GetNotes() {
+		report.Notes = append(report.Notes, &pbv1.Note{Satellite: note.GetSatellite(), Message: note.GetMessage()})
+	}
+	return report
+}
+
+func v1Result(sat *pb.Satellite, err error) (*pbv1.Satellite, error) {
+	if err != nil {
+		return nil, err
+	}
+	return v1Satellite(sat), nil
+}
+
+func v2SatelliteFilter(filter *pbv1.SatelliteFilter) *pb.SatelliteFilter {
+	return &pb.SatelliteFilter{
+		SatId:      filter.GetSatId(),
+		PageSize:   filter.GetPageSize(),
+		PageToken:  filter.GetPageToken(),
+		Descending: filter.GetDescending(),
+	}
+}
+
+func v2TimestampFilter(filter *pbv1.TimestampFilter) *pb.TimestampFilter {
+	return &pb.TimestampFilter{
+		StartTime:  filter.GetStartTime(),
+		EndTime:    filter.GetEndTime(),
+		SatId:      filter.GetSatId(),
+		PageSize:   filter.GetPageSize(),
+		PageToken:  filter.GetPageToken(),
+		Descending: filter.GetDescending(),
+	}
+}
+
+// v2SatelliteType converts the satellite type of the v1 API, a string, to
+// the enum of the v2 API.
+func v2SatelliteType(t string) (pb.SatelliteType, error) {
+	satType := database.SatelliteTypeProtobuf(t)
+	if t != "" && satType == pb.SatelliteType_SATELLITE_TYPE_UNSPECIFIED {
+		return 0, invalidArgument("type", "Unknown satellite type %q", t)
+	}
+	return satType, nil
+}
+
+func v2Satellite(sat *pbv1.Satellite) (*pb.Satellite, error) {
+	satType, err := v2SatelliteType(sat.GetSatType())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Satellite{
+		Id:          sat.GetId(),
+		Name:        sat.GetName(),
+		Deleted:     sat.GetDeleted(),
+		Type:        satType,
+		Description: sat.GetDescription(),
+		Mission:     sat.GetMission(),
+		Operator:    sat.GetOperator(),
+		LaunchDate:  sat.GetLaunchDate(),
+		Status:      sat.GetStatus(),
+		Tags:        sat.GetTags(),
+	}, nil
+}
+
+func v1Satellite(sat *pb.Satellite) *pbv1.Satellite {
+	return &pbv1.Satellite{
+		Id:          sat.GetId(),
+		Name:        sat.GetName(),
+		Deleted:     sat.GetDeleted(),
+		SatType:     database.SatelliteTypeOf(sat.GetType()),
+		Description: sat.GetDescription(),
+		Mission:     sat.GetMission(),
+		Operator:    sat.GetOperator(),
+		LaunchDate:  sat.GetLaunchDate(),
+		Status:      sat.GetStatus(),
+		Tags:        sat.GetTags(),
+	}
+}
+
+// v2Measurement converts a measurement of the v1 API. Its specific
+// measurement is a string, so it is always the other case.
+func v2Measurement(m *pbv1.Measurement) *pb.Measurement {
+	if m == nil {
+		return nil
+	}
+	measurement := &pb.Measurement{
+		Id:             m.GetId(),
+		FileName:       m.GetFileName(),
+		SatId:          m.GetIdSat(),
+		Timestamp:      m.GetTimestamp(),
+		IonoIndex:      float64(m.GetIonoIndex()),
+		NdviIndex:      float64(m.GetNdviIndex()),
+		RadiationIndex: float64(m.GetRadiationIndex()),
+		Version:        m.GetVersion(),
+	}
+	if m.GetSpecificMeasurement() != "" {
+		measurement.Specific = &pb.Measurement_Other{Other: m.GetSpecificMeasurement()}
+	}
+	return measurement
+}
+
+func v1Measurement(m *pb.Measurement) *pbv1.Measurement {
+	measurement := &pbv1.Measurement{
+		Id:             m.GetId(),
+		FileName:       m.GetFileName(),
+		IdSat:          m.GetSatId(),
+		Timestamp:      m.GetTimestamp(),
+		IonoIndex:      float32(m.GetIonoIndex()),
+		NdviIndex:      float32(m.GetNdviIndex()),
+		RadiationIndex: float32(m.GetRadiationIndex()),
+		Version:        m.GetVersion(),
+	}
+	switch specific := m.GetSpecific().(type) {
+	case *pb.Measurement_Altitude:
+		measurement.SpecificMeasurement = strconv.FormatFloat(specific.Altitude, 'f', -1, 64)
+	case *pb.Measurement_SeaSalinity:
+		measurement.SpecificMeasurement = strconv.FormatFloat(specific.SeaSalinity, 'f', -1, 64)
+	case *pb.Measurement_Vegetation:
+		measurement.SpecificMeasurement = specific.Vegetation
+	case *pb.Measurement_Other:
+		measurement.SpecificMeasurement = specific.Other
+	}
+	return measurement
+}
+
+func v1MeasurementResponse(r *pb.MeasurementResponse) *pbv1.MeasurementResponse {
+	measurements := make([]*pbv1.Measurement, 0, len(r.GetMeasurements()))
+	for _, m := range r.GetMeasurements() {
+		measurements = append(measurements, v1Measurement(m))
+	}
+	return &pbv1.MeasurementResponse{Measurements: measurements, NextPageToken: r.GetNextPageToken()}
+}
+
+func v2Computation(c *pbv1.Computation) *pb.Computation {
+	if c == nil {
+		return nil
+	}
+	return &pb.Computation{
+		Id:       c.GetId(),
+		SatId:    c.GetIdSat(),
+		Duration: c.GetDuration(),
+		MaxIono:  float64(c.GetMaxIono()),
+		MinIono:  float64(c.GetMinIono()),
+		AvgIono:  float64(c.GetAvgIono()),
+		MaxNdvi:  float64(c.GetMaxNdvi()),
+		MinNdvi:  float64(c.GetMinNdvi()),
+		AvgNdvi:  float64(c.GetAvgNdvi()),
+		MaxRad:   float64(c.GetMaxRad()),
+		MinRad:   float64(c.GetMinRad()),
+		AvgRad:   float64(c.GetAvgRad()),
+		MaxSpec:  float64(c.GetMaxSpec()),
+		MinSpec:  float64(c.GetMinSpec()),
+		AvgSpec:  float64(c.GetAvgSpec()),
+		Version:  c.GetVersion(),
+	}
+}
+
+func v1Computation(c *pb.Computation) *pbv1.Computation {
+	if c == nil {
+		return nil
+	}
+	return &pbv1.Computation{
+		Id:       c.GetId(),
+		IdSat:    c.GetSatId(),
+		Duration: c.GetDuration(),
+		MaxIono:  float32(c.GetMaxIono()),
+		MinIono:  float32(c.GetMinIono()),
+		AvgIono:  float32(c.GetAvgIono()),
+		MaxNdvi:  float32(c.GetMaxNdvi()),
+		MinNdvi:  float32(c.GetMinNdvi()),
+		AvgNdvi:  float32(c.GetAvgNdvi()),
+		MaxRad:   float32(c.GetMaxRad()),
+		MinRad:   float32(c.GetMinRad()),
+		AvgRad:   float32(c.GetAvgRad()),
+		MaxSpec:  float32(c.GetMaxSpec()),
+		MinSpec:  float32(c.GetMinSpec()),
+		AvgSpec:  float32(c.GetAvgSpec()),
+		Version:  c.GetVersion(),
+	}
+}
+
+// v1Fields maps the v1 fields whose v2 name is not just the snake case of
+// their name.
+var v1Fields = map[string]string{
+	"idSat":               "sat_id",
+	"satType":             "type",
+	"specificMeasurement": "other",
+}
+
+// v2Mask converts a field mask of the v1 API, whose paths are camel case.
+func v2Mask(mask *fieldmaskpb.FieldMask) *fieldmaskpb.FieldMask {
+	if mask == nil {
+		return nil
+	}
+	paths := make([]string, 0, len(mask.GetPaths()))
+	for _, path := range mask.GetPaths() {
+		if field, ok := v1Fields[path]; ok {
+			paths = append(paths, field)
+			continue
+		}
+		var b strings.Builder
+		for _, r := range path {
+			if unicode.IsUpper(r) {
+				b.WriteByte('_')
+				r = unicode.ToLower(r)
+			}
+			b.WriteRune(r)
+		}
+		paths = append(paths, b.String())
+	}
+	return &fieldmaskpb.FieldMask{Paths: paths}
+}
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"errors"
+
+	"github.com/Simek13/satelliteApp/internal/database"
+	"github.com/Simek13/satelliteApp/internal/validate"
+	pb "github.com/Simek13/satelliteApp/pkg/v2"
+)
+
+// The check functions validate request messages before they reach the
+// database. The fields that only exist in the API, like enums and
+// timestamps, are checked here, the stored values by the validate package.
+// Like the validate package, they check the fields named by fields, or all
+// of them if there are none.
+
+func checkSatellite(sat *pb.Satellite, fields ...string) error {
+	c := validate.New(fields...)
+	_, ok := pb.SatelliteType_name[int32(sat.GetType())]
+	c.Check("type", ok, "unknown satellite type %v", sat.GetType())
+	if sat.GetLaunchDate() != nil {
+		err := sat.GetLaunchDate().CheckValid()
+		c.Check("launch_date", err == nil, "%v", err)
+	}
+	c.Add(validate.Satellite(database.NewSatellite(sat), fields...))
+	return c.Err()
+}
+
+func checkMeasurement(m *pb.Measurement, fields ...string) error {
+	c := validate.New(fields...)
+	if m.GetTimestamp() == nil {
+		c.Check("timestamp", false, "is required")
+	} else {
+		err := m.GetTimestamp().CheckValid()
+		c.Check("timestamp", err == nil, "%v", err)
+	}
+	switch specific := m.GetSpecific().(type) {
+	case *pb.Measurement_Altitude:
+		c.NonNegative("altitude", specific.Altitude)
+	case *pb.Measurement_SeaSalinity:
+		c.NonNegative("sea_salinity", specific.SeaSalinity)
+	}
+	c.Add(validate.Measurement(database.NewMeasurement(m), fields...))
+	return c.Err()
+}
+
+func checkComputation(comp *pb.Computation, fields ...string) error {
+	c := validate.New(fields...)
+	if comp.GetDuration() != nil {
+		err := comp.GetDuration().CheckValid()
+		c.Check("duration", err == nil, "%v", err)
+	}
+	c.Add(validate.Computation(database.NewComputation(comp), fields...))
+	return c.Err()
+}
+
+// checkId validates the id of the row a request names.
+func checkId(id int32) error {
+	c := validate.New()
+	c.Positive("id", int(id))
+	return c.Err()
+}
+
+// selected reports whether field is one of fields, which select every field
+// if there are none.
+func selected(fields []string, field string) bool {
+	return validate.New(fields...).Selected(field)
+}
+
+// prefixed prefixes the fields of the violations of err with the field of
+// the message in the request.
+func prefixed(err error, prefix string) error {
+	var verr *validate.Error
+	if errors.As(err, &verr) {
+		return verr.Prefixed(prefix)
+	}
+	return err
+}
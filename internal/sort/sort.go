@@ -2,29 +2,180 @@ package sort
 
 import (
 	"fmt"
+	"math"
 	"sort"
 )
 
-type kv struct {
+// Order is the direction in which a statistic is ranked.
+type Order int
+
+const (
+	Descending Order = iota
+	Ascending
+)
+
+// RankMode decides how rank numbers are assigned to tied entries.
+type RankMode int
+
+const (
+	// Competition ranking leaves gaps after ties (1, 2, 2, 4).
+	Competition RankMode = iota
+	// Dense ranking does not leave gaps after ties (1, 2, 2, 3).
+	Dense
+)
+
+// Value is the field name used for rows built from a plain map of values.
+const Value = "value"
+
+type KV struct {
 	Key   string
 	Value float64
 }
 
-func (ss kv) String() string {
+func (ss KV) String() string {
 	return fmt.Sprintf("%v - %v", ss.Key, ss.Value)
 }
 
-func Sort(data map[string]float64) []kv {
+// Row holds the statistics of one satellite, keyed by statistic name.
+type Row struct {
+	Key    string
+	Values map[string]float64
+}
+
+// SortKey is one level of a multi-key sort.
+type SortKey struct {
+	Field string
+	Order Order
+}
+
+type Entry struct {
+	Rank int
+	Row
+}
+
+// Value returns the entry's value for the given field and whether it is set.
+func (e Entry) Value(field string) (float64, bool) {
+	v, ok := e.Values[field]
+	return v, ok
+}
+
+func (e Entry) String() string {
+	return fmt.Sprintf("%v. %v", e.Rank, e.Key)
+}
+
+type Ranking []Entry
+
+// Sort returns data sorted by value, descending, with ties broken by key.
+func Sort(data map[string]float64) []KV {
+	ranking := RankValues(data, Descending, Competition)
+
+	ss := make([]KV, 0, len(ranking))
+	for _, e := range ranking {
+		ss = append(ss, KV{e.Key, e.Values[Value]})
+	}
+
+	return ss
+}
 
-	var ss []kv
+// RankValues ranks a single statistic given as a map of satellite id to value.
+func RankValues(data map[string]float64, order Order, mode RankMode) Ranking {
+	rows := make([]Row, 0, len(data))
 	for k, v := range data {
-		ss = append(ss, kv{k, v})
+		rows = append(rows, Row{Key: k, Values: map[string]float64{Value: v}})
+	}
+	return Rank(rows, mode, SortKey{Value, order})
+}
+
+// Rank sorts rows by the given keys in priority order and assigns rank numbers.
+// Rows that compare equal on every key are tied; they share a rank and are
+// ordered by key so the result is deterministic. Missing and NaN values always
+// sort last, regardless of order.
+func Rank(rows []Row, mode RankMode, keys ...SortKey) Ranking {
+	ranking := make(Ranking, 0, len(rows))
+	for _, r := range rows {
+		ranking = append(ranking, Entry{Row: r})
 	}
 
-	sort.Slice(ss, func(i, j int) bool {
-		return ss[i].Value > ss[j].Value
+	sort.SliceStable(ranking, func(i, j int) bool {
+		if c := compare(ranking[i].Row, ranking[j].Row, keys); c != 0 {
+			return c < 0
+		}
+		return ranking[i].Key < ranking[j].Key
 	})
 
-	return ss
+	rank := 0
+	for i := range ranking {
+		switch {
+		case i > 0 && compare(ranking[i-1].Row, ranking[i].Row, keys) == 0:
+		case mode == Dense:
+			rank++
+		default:
+			rank = i + 1
+		}
+		ranking[i].Rank = rank
+	}
+
+	return ranking
+}
+
+// compare returns -1 if a ranks before b, 1 if b ranks before a and 0 on a tie.
+func compare(a, b Row, keys []SortKey) int {
+	for _, k := range keys {
+		va, oka := a.Values[k.Field]
+		vb, okb := b.Values[k.Field]
+		oka = oka && !math.IsNaN(va)
+		okb = okb && !math.IsNaN(vb)
+
+		switch {
+		case !oka && !okb:
+			continue
+		case !oka:
+			return 1
+		case !okb:
+			return -1
+		case va == vb:
+			continue
+		}
+
+		less := va < vb
+		if k.Order == Descending {
+			less = !less
+		}
+		if less {
+			return -1
+		}
+		return 1
+	}
+	return 0
+}
+
+// Top returns the first n entries of the ranking.
+func (r Ranking) Top(n int) Ranking {
+	if n < 0 {
+		n = 0
+	}
+	if n > len(r) {
+		n = len(r)
+	}
+	return r[:n]
+}
 
+// Bottom returns the last n entries of the ranking, in ranking order.
+func (r Ranking) Bottom(n int) Ranking {
+	if n < 0 {
+		n = 0
+	}
+	if n > len(r) {
+		n = len(r)
+	}
+	return r[len(r)-n:]
+}
+
+// Keys returns the keys of the ranked entries in ranking order.
+func (r Ranking) Keys() []string {
+	keys := make([]string, 0, len(r))
+	for _, e := range r {
+		keys = append(keys, e.Key)
+	}
+	return keys
 }
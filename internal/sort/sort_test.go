@@ -0,0 +1,92 @@
+package sort
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSort(t *testing.T) {
+	got := Sort(map[string]float64{"8J14": 10, "30J14": 6, "6N14": 19.5, "13A14": 10})
+	want := []KV{{"6N14", 19.5}, {"13A14", 10}, {"8J14", 10}, {"30J14", 6}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Sort() = %v, want %v", got, want)
+	}
+}
+
+func TestRankValues(t *testing.T) {
+	data := map[string]float64{"a": 3, "b": 1, "c": 3, "d": 2, "e": math.NaN()}
+	tests := []struct {
+		name      string
+		order     Order
+		mode      RankMode
+		wantKeys  []string
+		wantRanks []int
+	}{
+		{"descending competition", Descending, Competition, []string{"a", "c", "d", "b", "e"}, []int{1, 1, 3, 4, 5}},
+		{"descending dense", Descending, Dense, []string{"a", "c", "d", "b", "e"}, []int{1, 1, 2, 3, 4}},
+		{"ascending competition", Ascending, Competition, []string{"b", "d", "a", "c", "e"}, []int{1, 2, 3, 3, 5}},
+		{"ascending dense", Ascending, Dense, []string{"b", "d", "a", "c", "e"}, []int{1, 2, 3, 3, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RankValues(data, tt.order, tt.mode)
+			if keys := got.Keys(); !reflect.DeepEqual(keys, tt.wantKeys) {
+				t.Errorf("RankValues() keys = %v, want %v", keys, tt.wantKeys)
+			}
+			ranks := make([]int, 0, len(got))
+			for _, e := range got {
+				ranks = append(ranks, e.Rank)
+			}
+			if !reflect.DeepEqual(ranks, tt.wantRanks) {
+				t.Errorf("RankValues() ranks = %v, want %v", ranks, tt.wantRanks)
+			}
+		})
+	}
+}
+
+func TestRankMultiKey(t *testing.T) {
+	rows := []Row{
+		{"30J14", map[string]float64{"avgRad": 32, "maxIono": 7}},
+		{"8J14", map[string]float64{"avgRad": 41, "maxIono": 10}},
+		{"6N14", map[string]float64{"avgRad": 32, "maxIono": 20}},
+		{"13A14", map[string]float64{"avgRad": 32, "maxIono": 7}},
+		{"1X1", map[string]float64{"maxIono": 1}},
+	}
+
+	got := Rank(rows, Competition, SortKey{"avgRad", Descending}, SortKey{"maxIono", Ascending})
+	wantKeys := []string{"8J14", "13A14", "30J14", "6N14", "1X1"}
+	if keys := got.Keys(); !reflect.DeepEqual(keys, wantKeys) {
+		t.Errorf("Rank() keys = %v, want %v", keys, wantKeys)
+	}
+	wantRanks := []int{1, 2, 2, 4, 5}
+	for i, e := range got {
+		if e.Rank != wantRanks[i] {
+			t.Errorf("Rank() %s rank = %d, want %d", e.Key, e.Rank, wantRanks[i])
+		}
+	}
+}
+
+func TestRankingTopBottom(t *testing.T) {
+	r := RankValues(map[string]float64{"a": 4, "b": 3, "c": 2, "d": 1}, Descending, Competition)
+	tests := []struct {
+		name string
+		got  Ranking
+		want []string
+	}{
+		{"top 3", r.Top(3), []string{"a", "b", "c"}},
+		{"top too many", r.Top(10), []string{"a", "b", "c", "d"}},
+		{"top negative", r.Top(-1), []string{}},
+		{"bottom 2", r.Bottom(2), []string{"c", "d"}},
+		{"bottom too many", r.Bottom(10), []string{"a", "b", "c", "d"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if keys := tt.got.Keys(); !reflect.DeepEqual(keys, tt.want) {
+				t.Errorf("got %v, want %v", keys, tt.want)
+			}
+		})
+	}
+}
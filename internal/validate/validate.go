@@ -0,0 +1,264 @@
+// Package validate checks satellites, measurements and computations before
+// they are stored. The same rules apply to values sent to the API and to
+// values read from ingested files.
+package validate
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/Simek13/satelliteApp/internal/database"
+	"github.com/Simek13/satelliteApp/internal/satellites"
+	"github.com/pkg/errors"
+)
+
+// Limits of stored values. The lengths are those of the database columns.
+const (
+	MaxNameLength        = 32
+	MaxFileNameLength    = 32
+	MaxSpecificLength    = 32
+	MaxDescriptionLength = 1024
+	MaxLabelLength       = 64
+	MaxNdviIndex         = 100
+)
+
+// MinTimestamp is the earliest valid measurement time, the launch of the
+// first satellite.
+var MinTimestamp = time.Date(1957, 10, 4, 0, 0, 0, 0, time.UTC)
+
+// Violation is a field with an invalid value.
+type Violation struct {
+	Field       string
+	Description string
+}
+
+// Error lists the violations of a value.
+type Error struct {
+	Violations []Violation
+}
+
+func (e *Error) Error() string {
+	msgs := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		msgs = append(msgs, v.Field+": "+v.Description)
+	}
+	return "invalid " + strings.Join(msgs, ", ")
+}
+
+// Prefixed returns the error with prefix added to the fields, to name the
+// fields of a value nested in another one.
+func (e *Error) Prefixed(prefix string) *Error {
+	violations := make([]Violation, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		violations = append(violations, Violation{Field: prefix + v.Field, Description: v.Description})
+	}
+	return &Error{Violations: violations}
+}
+
+// Checker collects the violations of the fields of a value. Checks of
+// fields that were not selected are skipped, so partial updates only check
+// the fields they write.
+type Checker struct {
+	fields     map[string]bool
+	violations []Violation
+}
+
+// New returns a checker of the fields, or of every field if there are none.
+func New(fields ...string) *Checker {
+	c := &Checker{}
+	if len(fields) > 0 {
+		c.fields = make(map[string]bool, len(fields))
+		for _, field := range fields {
+			c.fields[field] = true
+		}
+	}
+	return c
+}
+
+// Selected reports whether field is checked.
+func (c *Checker) Selected(field string) bool {
+	return c.fields == nil || c.fields[field]
+}
+
+// Check adds a violation of field if ok is false.
+func (c *Checker) Check(field string, ok bool, format string, args ...interface{}) {
+	if !ok && c.Selected(field) {
+		c.violations = append(c.violations, Violation{Field: field, Description: fmt.Sprintf(format, args...)})
+	}
+}
+
+func (c *Checker) Required(field, value string) {
+	c.Check(field, value != "", "is required")
+}
+
+func (c *Checker) MaxLength(field, value string, max int) {
+	c.Check(field, len(value) <= max, "is longer than %d characters", max)
+}
+
+func (c *Checker) Positive(field string, value int) {
+	c.Check(field, value > 0, "must be positive, not %d", value)
+}
+
+// Range checks that value is a number in [min, max].
+func (c *Checker) Range(field string, value, min, max float64) {
+	c.Check(field, value >= min && value <= max, "%v is not between %v and %v", value, min, max)
+}
+
+// NonNegative checks that value is a finite number that is not negative.
+func (c *Checker) NonNegative(field string, value float64) {
+	c.Check(field, value >= 0 && !math.IsInf(value, 1), "%v is not a non-negative number", value)
+}
+
+// Timestamp checks that t is set and not before MinTimestamp.
+func (c *Checker) Timestamp(field string, t time.Time) {
+	if t.IsZero() {
+		c.Check(field, false, "is required")
+		return
+	}
+	c.Check(field, !t.Before(MinTimestamp), "%v is before %v", t.Format(time.RFC3339), MinTimestamp.Format("2006-01-02"))
+}
+
+// Add adds the violations of err, an *Error, of fields that have no
+// violation yet. Other errors are ignored.
+func (c *Checker) Add(err error) {
+	var verr *Error
+	if !errors.As(err, &verr) {
+		return
+	}
+	violated := make(map[string]bool, len(c.violations))
+	for _, v := range c.violations {
+		violated[v.Field] = true
+	}
+	for _, v := range verr.Violations {
+		if !violated[v.Field] {
+			c.violations = append(c.violations, v)
+		}
+	}
+}
+
+// Err returns an *Error of the violations, or nil if there are none.
+func (c *Checker) Err() error {
+	if len(c.violations) == 0 {
+		return nil
+	}
+	return &Error{Violations: c.violations}
+}
+
+// specificFields are the fields of the cases of the specific measurement
+// oneof of the API, which are stored in the same value.
+var specificFields = []string{"altitude", "sea_salinity", "vegetation", "other"}
+
+// Measurement checks the fields of m named by fields, or all of them. The
+// cases of the specific measurement are checked as the field "specific".
+func Measurement(m *database.Measurement, fields ...string) error {
+	c := New(fields...)
+	for _, field := range specificFields {
+		if c.fields[field] {
+			c.fields["specific"] = true
+		}
+	}
+	c.Required("file_name", m.FileName)
+	c.MaxLength("file_name", m.FileName, MaxFileNameLength)
+	c.Positive("sat_id", m.IdSat)
+	c.Timestamp("timestamp", m.Timestamp)
+	c.NonNegative("iono_index", m.IonoIndex)
+	c.Range("ndvi_index", m.NdviIndex, 0, MaxNdviIndex)
+	c.NonNegative("radiation_index", m.RadiationIndex)
+	c.MaxLength("specific", m.SpecificMeasurement, MaxSpecificLength)
+	return c.Err()
+}
+
+// Satellite checks the fields of s named by fields, or all of them.
+func Satellite(s *database.Satellite, fields ...string) error {
+	c := New(fields...)
+	c.Required("name", s.Name)
+	c.MaxLength("name", s.Name, MaxNameLength)
+	switch s.Type {
+	case "", satellites.Ea.String(), satellites.Vc.String(), satellites.Ss.String():
+	default:
+		c.Check("type", false, "unknown satellite type %q", s.Type)
+	}
+	switch s.Status {
+	case "", database.SatelliteActive, database.SatelliteRetired:
+	default:
+		c.Check("status", false, "unknown satellite status %q", s.Status)
+	}
+	c.MaxLength("description", s.Description, MaxDescriptionLength)
+	c.MaxLength("mission", s.Mission, MaxLabelLength)
+	c.MaxLength("operator", s.Operator, MaxLabelLength)
+	if s.LaunchDate != nil {
+		c.Timestamp("launch_date", *s.LaunchDate)
+	}
+	for _, tag := range s.Tags {
+		c.Required("tags", tag)
+		c.MaxLength("tags", tag, MaxLabelLength)
+	}
+	return c.Err()
+}
+
+// Computation checks the fields of comp named by fields, or all of them.
+func Computation(comp *database.Computation, fields ...string) error {
+	c := New(fields...)
+	c.Positive("sat_id", comp.IdSat)
+	c.Check("duration", comp.Duration >= 0, "%v is negative", comp.Duration)
+	for _, f := range []struct {
+		field string
+		value float64
+	}{
+		{"max_iono", comp.MaxIono}, {"min_iono", comp.MinIono}, {"avg_iono", comp.AvgIono},
+		{"max_ndvi", comp.MaxNdvi}, {"min_ndvi", comp.MinNdvi}, {"avg_ndvi", comp.AvgNdvi},
+		{"max_rad", comp.MaxRad}, {"min_rad", comp.MinRad}, {"avg_rad", comp.AvgRad},
+		{"max_spec", comp.MaxSpec}, {"min_spec", comp.MinSpec}, {"avg_spec", comp.AvgSpec},
+	} {
+		c.Check(f.field, !math.IsNaN(f.value) && !math.IsInf(f.value, 0), "%v is not a number", f.value)
+	}
+	return c.Err()
+}
+
+// Satellites looks up satellites, like database.Store.
+type Satellites interface {
+	GetSatellite(id int) (*database.Satellite, error)
+}
+
+// SatelliteExists checks that satellite id of field exists and is not
+// deleted. Errors of the lookup are returned as they are.
+func SatelliteExists(sats Satellites, field string, id int) error {
+	sat, err := sats.GetSatellite(id)
+	c := New()
+	switch {
+	case errors.Is(err, database.ErrNotFound):
+		c.Check(field, false, "satellite %d does not exist", id)
+	case err != nil:
+		return err
+	default:
+		notDeleted(c, field, sat)
+	}
+	return c.Err()
+}
+
+// SatelliteNames looks up satellites by name, like database.Store.
+type SatelliteNames interface {
+	GetSatelliteByName(name string) (*database.Satellite, error)
+}
+
+// SatelliteNotDeleted checks that the named satellite of field is not
+// deleted. Unknown names pass, ingesting a file adds them. Errors of the
+// lookup are returned as they are.
+func SatelliteNotDeleted(sats SatelliteNames, field, name string) error {
+	sat, err := sats.GetSatelliteByName(name)
+	c := New()
+	switch {
+	case errors.Is(err, database.ErrNotFound):
+	case err != nil:
+		return err
+	default:
+		notDeleted(c, field, sat)
+	}
+	return c.Err()
+}
+
+func notDeleted(c *Checker, field string, sat *database.Satellite) {
+	c.Check(field, sat.Deleted == nil, "satellite %q is deleted", sat.Name)
+}
@@ -0,0 +1,96 @@
+package validate
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Simek13/satelliteApp/internal/database"
+	"github.com/Simek13/satelliteApp/internal/database/memory"
+)
+
+func fields(err error) []string {
+	var verr *Error
+	if !errors.As(err, &verr) {
+		return nil
+	}
+	var fields []string
+	for _, v := range verr.Violations {
+		fields = append(fields, v.Field)
+	}
+	return fields
+}
+
+func TestMeasurement(t *testing.T) {
+	valid := database.Measurement{FileName: "a.csv", IdSat: 1, Timestamp: time.Date(2016, 02, 20, 15, 34, 0, 0, time.UTC), NdviIndex: 49}
+	tests := []struct {
+		name   string
+		modify func(m *database.Measurement)
+		fields []string
+		want   []string
+	}{
+		{"valid", func(m *database.Measurement) {}, nil, nil},
+		{"missing values", func(m *database.Measurement) { *m = database.Measurement{} }, nil, []string{"file_name", "sat_id", "timestamp"}},
+		{"out of range", func(m *database.Measurement) { m.IonoIndex, m.NdviIndex = -1, 101 }, nil, []string{"iono_index", "ndvi_index"}},
+		{"too long", func(m *database.Measurement) { m.FileName = strings.Repeat("a", 33) + ".csv" }, nil, []string{"file_name"}},
+		{"before first satellite", func(m *database.Measurement) { m.Timestamp = time.Date(1957, 10, 3, 0, 0, 0, 0, time.UTC) }, nil, []string{"timestamp"}},
+		{"unselected field", func(m *database.Measurement) { m.IdSat, m.IonoIndex = 0, -1 }, []string{"iono_index"}, []string{"iono_index"}},
+		{"specific case", func(m *database.Measurement) { m.SpecificMeasurement = strings.Repeat("a", 33) }, []string{"vegetation"}, []string{"specific"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := valid
+			tt.modify(&m)
+			if got := fields(Measurement(&m, tt.fields...)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Measurement() violations of fields %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSatellite(t *testing.T) {
+	tests := []struct {
+		name string
+		sat  database.Satellite
+		want []string
+	}{
+		{"valid", database.Satellite{Name: "30J14", Type: "Ea", Status: database.SatelliteActive, Tags: []string{"ocean"}}, nil},
+		{"missing name", database.Satellite{}, []string{"name"}},
+		{"unknown type and status", database.Satellite{Name: "30J14", Type: "Xx", Status: "lost"}, []string{"type", "status"}},
+		{"empty tag", database.Satellite{Name: "30J14", Tags: []string{"ocean", ""}}, []string{"tags"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fields(Satellite(&tt.sat)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Satellite() violations of fields %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSatelliteExists(t *testing.T) {
+	store := memory.New()
+	for _, name := range []string{"30J14", "8J14"} {
+		if err := store.AddSatellite(&database.Satellite{Name: name}); err != nil {
+			t.Fatalf("AddSatellite() error = %v", err)
+		}
+	}
+	if err := store.SoftDeleteSatellite(2); err != nil {
+		t.Fatalf("SoftDeleteSatellite() error = %v", err)
+	}
+
+	for id, want := range map[int][]string{1: nil, 2: {"sat_id"}, 9: {"sat_id"}} {
+		if got := fields(SatelliteExists(store, "sat_id", id)); !reflect.DeepEqual(got, want) {
+			t.Errorf("SatelliteExists(%d) violations of fields %v, want %v", id, got, want)
+		}
+	}
+	for name, want := range map[string][]string{"30J14": nil, "8J14": {"name"}, "6N14": nil} {
+		if got := fields(SatelliteNotDeleted(store, "name", name)); !reflect.DeepEqual(got, want) {
+			t.Errorf("SatelliteNotDeleted(%q) violations of fields %v, want %v", name, got, want)
+		}
+	}
+}
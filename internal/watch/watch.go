@@ -0,0 +1,227 @@
+// Package watch publishes newly stored measurements to subscribers.
+package watch
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Simek13/satelliteApp/internal/database"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// ErrSlowSubscriber ends a subscription that did not keep up with the
+// published measurements.
+var ErrSlowSubscriber = errors.New("subscriber is too slow")
+
+const (
+	// DefaultInterval is how often Run polls the store when it is not
+	// notified of new measurements.
+	DefaultInterval = time.Second
+	// BufferSize is the number of measurements a subscription holds before
+	// it is ended with ErrSlowSubscriber.
+	BufferSize = 1000
+	// GapTimeout is how long the hub waits for a missing measurement id to
+	// be committed before it skips it. Ids go missing for the time the
+	// transaction writing them is open, and for good when it is rolled back
+	// or the measurement is deleted before it was published. Measurements
+	// committed after their id was skipped are not published.
+	GapTimeout = 5 * time.Minute
+
+	pollBatchSize = 500
+)
+
+// Filter selects measurements. Zero fields select everything.
+type Filter struct {
+	SatId    int
+	FileName string
+}
+
+func (f Filter) Match(m database.Measurement) bool {
+	return (f.SatId == 0 || m.IdSat == f.SatId) && (f.FileName == "" || m.FileName == f.FileName)
+}
+
+// Hub publishes the measurements stored after it was created to the
+// subscriptions they match. It finds new measurements by polling the store in
+// id order, so measurements stored by other processes, like the command line
+// ingestion, are published as well. Writers in the same process call Notify
+// to have them published without waiting for the next poll.
+//
+// Ids are assigned when a measurement is inserted, but it is only read once
+// its transaction commits, so a long upload can commit measurements with
+// lower ids than ones stored after it started. The hub publishes in id order
+// without gaps: measurements after a missing id are held back until it is
+// committed, or for GapTimeout. The id of the last published measurement is
+// therefore always safe to resume after.
+type Hub struct {
+	db         database.MeasurementStore
+	interval   time.Duration
+	gapTimeout time.Duration
+	wake       chan struct{}
+	// gapSince is when the id after last was first missing, only used by Run
+	gapSince time.Time
+
+	mu sync.Mutex
+	// last is the id up to which every measurement was published or
+	// skipped, only written by Run
+	last int
+	subs map[*Subscription]struct{}
+}
+
+func NewHub(db database.MeasurementStore, interval time.Duration) (*Hub, error) {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	last, err := db.LastMeasurementId()
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to read last measurement id")
+	}
+	return &Hub{
+		db:         db,
+		interval:   interval,
+		gapTimeout: GapTimeout,
+		wake:       make(chan struct{}, 1),
+		last:       last,
+		subs:       make(map[*Subscription]struct{}),
+	}, nil
+}
+
+// Notify tells the hub that measurements were stored. It does not block.
+func (h *Hub) Notify() {
+	select {
+	case h.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run publishes new measurements until ctx is done.
+func (h *Hub) Run(ctx context.Context) error {
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		case <-h.wake:
+		}
+		if err := h.poll(time.Now()); err != nil {
+			log.WithFields(log.Fields{"event": "watch", "status": "failed", "error": err}).Warn("Unable to read new measurements")
+		}
+	}
+}
+
+// poll publishes the measurements stored after last up to the first missing
+// id, or past it once it was missing for the gap timeout.
+func (h *Hub) poll(now time.Time) error {
+	for {
+		ms, err := h.db.MeasurementsAfter(h.last, pollBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(ms) == 0 {
+			h.gapSince = time.Time{}
+			return nil
+		}
+
+		n := 0
+		for n < len(ms) && ms[n].Id == h.last+n+1 {
+			n++
+		}
+		if n == 0 {
+			if h.gapSince.IsZero() {
+				h.gapSince = now
+			}
+			if now.Sub(h.gapSince) < h.gapTimeout {
+				return nil
+			}
+			// give up on the missing ids
+			n = 1
+		}
+		h.gapSince = time.Time{}
+
+		h.publish(ms[:n])
+		h.mu.Lock()
+		h.last = ms[n-1].Id
+		h.mu.Unlock()
+		if n == len(ms) && len(ms) < pollBatchSize {
+			return nil
+		}
+	}
+}
+
+func (h *Hub) publish(ms []database.Measurement) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subs {
+		for _, m := range ms {
+			if !sub.filter.Match(m) {
+				continue
+			}
+			select {
+			case sub.c <- m:
+			default:
+				sub.err = ErrSlowSubscriber
+				h.remove(sub)
+			}
+			if sub.err != nil {
+				break
+			}
+		}
+	}
+}
+
+// Subscribe returns a subscription to the measurements matching f published
+// from now on. It must be closed when it is no longer used.
+func (h *Hub) Subscribe(f Filter) *Subscription {
+	sub := &Subscription{hub: h, filter: f, c: make(chan database.Measurement, BufferSize)}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	sub.since = h.last
+	h.subs[sub] = struct{}{}
+	return sub
+}
+
+// remove ends sub. h.mu must be held.
+func (h *Hub) remove(sub *Subscription) {
+	if _, ok := h.subs[sub]; ok {
+		delete(h.subs, sub)
+		close(sub.c)
+	}
+}
+
+// Subscription receives published measurements in id order.
+type Subscription struct {
+	hub    *Hub
+	filter Filter
+	c      chan database.Measurement
+	err    error
+	since  int
+}
+
+// C returns the channel of the measurements. It is closed when the
+// subscription ends.
+func (s *Subscription) C() <-chan database.Measurement {
+	return s.c
+}
+
+// Err returns why the subscription ended once C is closed, or nil if it was
+// closed by Close.
+func (s *Subscription) Err() error {
+	return s.err
+}
+
+// Since returns the id of the last measurement published before the
+// subscription started. It receives the measurements after it.
+func (s *Subscription) Since() int {
+	return s.since
+}
+
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	s.hub.remove(s)
+}
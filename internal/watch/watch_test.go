@@ -0,0 +1,187 @@
+package watch
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/Simek13/satelliteApp/internal/database"
+	"github.com/Simek13/satelliteApp/internal/database/memory"
+)
+
+func newTestStore(t *testing.T) *memory.Store {
+	store := memory.New()
+	for _, name := range []string{"30J14", "8J14"} {
+		if err := store.AddSatellite(&database.Satellite{Name: name}); err != nil {
+			t.Fatalf("AddSatellite() error = %v", err)
+		}
+	}
+	return store
+}
+
+func addMeasurement(t *testing.T, store *memory.Store, idSat int, filename string) int {
+	t.Helper()
+	m := &database.Measurement{FileName: filename, IdSat: idSat}
+	if err := store.AddMeasurement(m); err != nil {
+		t.Fatalf("AddMeasurement() error = %v", err)
+	}
+	return m.Id
+}
+
+func receive(t *testing.T, sub *Subscription) database.Measurement {
+	t.Helper()
+	select {
+	case m, ok := <-sub.C():
+		if !ok {
+			t.Fatalf("subscription ended, %v", sub.Err())
+		}
+		return m
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no measurement published")
+	}
+	return database.Measurement{}
+}
+
+func TestHub(t *testing.T) {
+	store := newTestStore(t)
+	// stored before the hub was created, not published
+	addMeasurement(t, store, 1, "old.csv")
+
+	hub, err := NewHub(store, time.Hour)
+	if err != nil {
+		t.Fatalf("NewHub() error = %v", err)
+	}
+	all := hub.Subscribe(Filter{})
+	defer all.Close()
+	sat2 := hub.Subscribe(Filter{SatId: 2})
+	defer sat2.Close()
+	file := hub.Subscribe(Filter{FileName: "b.csv"})
+	defer file.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error)
+	go func() { done <- hub.Run(ctx) }()
+
+	ids := []int{
+		addMeasurement(t, store, 1, "a.csv"),
+		addMeasurement(t, store, 2, "a.csv"),
+		addMeasurement(t, store, 1, "b.csv"),
+	}
+	hub.Notify()
+
+	for _, want := range ids {
+		if got := receive(t, all); got.Id != want {
+			t.Errorf("all received %d, want %d", got.Id, want)
+		}
+	}
+	if got := receive(t, sat2); got.Id != ids[1] {
+		t.Errorf("satellite 2 received %d, want %d", got.Id, ids[1])
+	}
+	if got := receive(t, file); got.Id != ids[2] {
+		t.Errorf("b.csv received %d, want %d", got.Id, ids[2])
+	}
+
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("Run() = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestHubSlowSubscriber(t *testing.T) {
+	hub, err := NewHub(newTestStore(t), time.Hour)
+	if err != nil {
+		t.Fatalf("NewHub() error = %v", err)
+	}
+	sub := hub.Subscribe(Filter{})
+
+	ms := make([]database.Measurement, BufferSize+1)
+	for i := range ms {
+		ms[i].Id = i + 1
+	}
+	hub.publish(ms)
+
+	n := 0
+	for range sub.C() {
+		n++
+	}
+	if n != BufferSize || sub.Err() != ErrSlowSubscriber {
+		t.Errorf("received %d measurements, %v, want %d, %v", n, sub.Err(), BufferSize, ErrSlowSubscriber)
+	}
+	// closing an ended subscription is a no-op
+	sub.Close()
+}
+
+// commitStore is a store whose measurements are read once they are
+// committed, like those of concurrent transactions.
+type commitStore struct {
+	database.MeasurementStore
+	committed []database.Measurement
+}
+
+func (s *commitStore) commit(ids ...int) {
+	for _, id := range ids {
+		s.committed = append(s.committed, database.Measurement{Id: id})
+	}
+	sort.Slice(s.committed, func(i, j int) bool { return s.committed[i].Id < s.committed[j].Id })
+}
+
+func (s *commitStore) MeasurementsAfter(id, limit int) ([]database.Measurement, error) {
+	ms := make([]database.Measurement, 0)
+	for _, m := range s.committed {
+		if m.Id > id && len(ms) < limit {
+			ms = append(ms, m)
+		}
+	}
+	return ms, nil
+}
+
+func (s *commitStore) LastMeasurementId() (int, error) {
+	return 0, nil
+}
+
+func TestHubLateCommit(t *testing.T) {
+	store := &commitStore{}
+	hub, err := NewHub(store, time.Hour)
+	if err != nil {
+		t.Fatalf("NewHub() error = %v", err)
+	}
+	sub := hub.Subscribe(Filter{})
+	defer sub.Close()
+
+	now := time.Now()
+	steps := []struct {
+		commit   []int
+		after    time.Duration
+		want     []int
+		wantLast int
+	}{
+		// measurement 1 of a long upload commits after measurement 2, which
+		// is held back until then
+		{[]int{2}, 0, nil, 0},
+		{nil, time.Second, nil, 0},
+		{[]int{1, 3}, 2 * time.Second, []int{1, 2, 3}, 3},
+		// measurement 4 is rolled back, 5 is published after the gap timeout
+		{[]int{5}, 3 * time.Second, nil, 3},
+		{[]int{6}, 3*time.Second + GapTimeout, []int{5, 6}, 6},
+	}
+
+	for i, step := range steps {
+		store.commit(step.commit...)
+		if err := hub.poll(now.Add(step.after)); err != nil {
+			t.Fatalf("poll() error = %v", err)
+		}
+		var got []int
+		for len(sub.C()) > 0 {
+			got = append(got, (<-sub.C()).Id)
+		}
+		if !reflect.DeepEqual(got, step.want) || hub.last != step.wantLast {
+			t.Errorf("step %d published %v up to %d, want %v up to %d", i, got, hub.last, step.want, step.wantLast)
+		}
+	}
+	if since := hub.Subscribe(Filter{}).Since(); since != 6 {
+		t.Errorf("Since() = %d, want 6", since)
+	}
+}
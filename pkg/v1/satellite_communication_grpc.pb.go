@@ -0,0 +1,956 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package satellitecommunication
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// SatelliteCommunicationClient is the client API for SatelliteCommunication service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SatelliteCommunicationClient interface {
+	GetMeasurements(ctx context.Context, in *SatelliteFilter, opts ...grpc.CallOption) (*MeasurementResponse, error)
+	// GetMeasurementsBetween returns the measurements of a satellite taken in
+	// [startTime, endTime), ordered by timestamp. Unset times are unbounded.
+	GetMeasurementsBetween(ctx context.Context, in *TimestampFilter, opts ...grpc.CallOption) (*MeasurementResponse, error)
+	// StreamMeasurements sends every measurement selected by the filter, one
+	// message at a time, ordered like GetMeasurementsBetween. Paging fields
+	// other than descending are ignored. Through the gateway the stream is
+	// returned as newline delimited JSON.
+	StreamMeasurements(ctx context.Context, in *TimestampFilter, opts ...grpc.CallOption) (SatelliteCommunication_StreamMeasurementsClient, error)
+	// WatchMeasurements sends the measurements selected by the filter as they
+	// are stored, until the client cancels. A client that reconnects sets
+	// afterId to the id of the last measurement it received to get the ones
+	// it missed first.
+	WatchMeasurements(ctx context.Context, in *WatchFilter, opts ...grpc.CallOption) (SatelliteCommunication_WatchMeasurementsClient, error)
+	GetComputations(ctx context.Context, in *SatelliteFilter, opts ...grpc.CallOption) (*ComputationResponse, error)
+	AddSatellite(ctx context.Context, in *Satellite, opts ...grpc.CallOption) (*Satellite, error)
+	// ListSatellites returns the satellites ordered by id. Soft deleted
+	// satellites are only listed if show_deleted is set. The other filter
+	// fields select the satellites with the given value when they are set.
+	ListSatellites(ctx context.Context, in *ListSatellitesRequest, opts ...grpc.CallOption) (*SatelliteResponse, error)
+	// GetSatellite returns the satellite with the id, or the named one if id
+	// is 0. Soft deleted satellites are returned with their deleted time.
+	GetSatellite(ctx context.Context, in *GetSatelliteRequest, opts ...grpc.CallOption) (*Satellite, error)
+	// UpdateSatellite writes the fields of satellite named by update_mask, or
+	// every field if it is empty, to the stored satellite with the same id.
+	UpdateSatellite(ctx context.Context, in *UpdateSatelliteRequest, opts ...grpc.CallOption) (*Satellite, error)
+	// DeleteSatellite deletes the satellite with the id. A satellite with
+	// measurements or computations is only deleted together with them if
+	// cascade is set. With soft set, the satellite is only marked as deleted
+	// and keeps its data.
+	DeleteSatellite(ctx context.Context, in *DeleteSatelliteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddMeasurement(ctx context.Context, in *Measurement, opts ...grpc.CallOption) (*Measurement, error)
+	// UpdateMeasurement writes the fields of measurement named by
+	// update_mask, or every field if it is empty, to the stored measurement
+	// with the same id. If measurement.version is set it must be the stored
+	// version. Each update increments the version.
+	UpdateMeasurement(ctx context.Context, in *UpdateMeasurementRequest, opts ...grpc.CallOption) (*Measurement, error)
+	// DeleteMeasurement deletes the measurement with the id. If version is
+	// set it must be the stored version.
+	DeleteMeasurement(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UploadMeasurements stores a stream of measurements in a single
+	// transaction and records the upload as an ingest run. Measurements that
+	// fail validation are rejected and reported in the summary, the others
+	// are stored. Through the gateway the request body is newline delimited
+	// JSON.
+	UploadMeasurements(ctx context.Context, opts ...grpc.CallOption) (SatelliteCommunication_UploadMeasurementsClient, error)
+	// IngestFile reads, computes and stores a csv file sent in chunks, the
+	// same way satelliteApp ingests a file, and returns the run report. The
+	// file is stored completely or not at all. Through the gateway the file
+	// is posted as the "file" part of a multipart form to /v1/ingest.
+	IngestFile(ctx context.Context, opts ...grpc.CallOption) (SatelliteCommunication_IngestFileClient, error)
+	AddComputation(ctx context.Context, in *Computation, opts ...grpc.CallOption) (*Computation, error)
+	// UpdateComputation works like UpdateMeasurement.
+	// ComputeStatistics computes the statistics of the measurements of a
+	// satellite taken in [startTime, endTime) the same way they are computed
+	// when a file is read. Only the requested statistics are set, all of them
+	// if none are requested. With persist set, the computation is also stored.
+	ComputeStatistics(ctx context.Context, in *ComputeStatisticsRequest, opts ...grpc.CallOption) (*ComputeStatisticsResponse, error)
+	UpdateComputation(ctx context.Context, in *UpdateComputationRequest, opts ...grpc.CallOption) (*Computation, error)
+	DeleteComputation(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type satelliteCommunicationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSatelliteCommunicationClient(cc grpc.ClientConnInterface) SatelliteCommunicationClient {
+	return &satelliteCommunicationClient{cc}
+}
+
+func (c *satelliteCommunicationClient) GetMeasurements(ctx context.Context, in *SatelliteFilter, opts ...grpc.CallOption) (*MeasurementResponse, error) {
+	out := new(MeasurementResponse)
+	err := c.cc.Invoke(ctx, "/satellitecommunication.v1.SatelliteCommunication/GetMeasurements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *satelliteCommunicationClient) GetMeasurementsBetween(ctx context.Context, in *TimestampFilter, opts ...grpc.CallOption) (*MeasurementResponse, error) {
+	out := new(MeasurementResponse)
+	err := c.cc.Invoke(ctx, "/satellitecommunication.v1.SatelliteCommunication/GetMeasurementsBetween", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *satelliteCommunicationClient) StreamMeasurements(ctx context.Context, in *TimestampFilter, opts ...grpc.CallOption) (SatelliteCommunication_StreamMeasurementsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SatelliteCommunication_ServiceDesc.Streams[0], "/satellitecommunication.v1.SatelliteCommunication/StreamMeasurements", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &satelliteCommunicationStreamMeasurementsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SatelliteCommunication_StreamMeasurementsClient interface {
+	Recv() (*Measurement, error)
+	grpc.ClientStream
+}
+
+type satelliteCommunicationStreamMeasurementsClient struct {
+	grpc.ClientStream
+}
+
+func (x *satelliteCommunicationStreamMeasurementsClient) Recv() (*Measurement, error) {
+	m := new(Measurement)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *satelliteCommunicationClient) WatchMeasurements(ctx context.Context, in *WatchFilter, opts ...grpc.CallOption) (SatelliteCommunication_WatchMeasurementsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SatelliteCommunication_ServiceDesc.Streams[1], "/satellitecommunication.v1.SatelliteCommunication/WatchMeasurements", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &satelliteCommunicationWatchMeasurementsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SatelliteCommunication_WatchMeasurementsClient interface {
+	Recv() (*Measurement, error)
+	grpc.ClientStream
+}
+
+type satelliteCommunicationWatchMeasurementsClient struct {
+	grpc.ClientStream
+}
+
+func (x *satelliteCommunicationWatchMeasurementsClient) Recv() (*Measurement, error) {
+	m := new(Measurement)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *satelliteCommunicationClient) GetComputations(ctx context.Context, in *SatelliteFilter, opts ...grpc.CallOption) (*ComputationResponse, error) {
+	out := new(ComputationResponse)
+	err := c.cc.Invoke(ctx, "/satellitecommunication.v1.SatelliteCommunication/GetComputations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *satelliteCommunicationClient) AddSatellite(ctx context.Context, in *Satellite, opts ...grpc.CallOption) (*Satellite, error) {
+	out := new(Satellite)
+	err := c.cc.Invoke(ctx, "/satellitecommunication.v1.SatelliteCommunication/AddSatellite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *satelliteCommunicationClient) ListSatellites(ctx context.Context, in *ListSatellitesRequest, opts ...grpc.CallOption) (*SatelliteResponse, error) {
+	out := new(SatelliteResponse)
+	err := c.cc.Invoke(ctx, "/satellitecommunication.v1.SatelliteCommunication/ListSatellites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *satelliteCommunicationClient) GetSatellite(ctx context.Context, in *GetSatelliteRequest, opts ...grpc.CallOption) (*Satellite, error) {
+	out := new(Satellite)
+	err := c.cc.Invoke(ctx, "/satellitecommunication.v1.SatelliteCommunication/GetSatellite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *satelliteCommunicationClient) UpdateSatellite(ctx context.Context, in *UpdateSatelliteRequest, opts ...grpc.CallOption) (*Satellite, error) {
+	out := new(Satellite)
+	err := c.cc.Invoke(ctx, "/satellitecommunication.v1.SatelliteCommunication/UpdateSatellite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *satelliteCommunicationClient) DeleteSatellite(ctx context.Context, in *DeleteSatelliteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/satellitecommunication.v1.SatelliteCommunication/DeleteSatellite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *satelliteCommunicationClient) AddMeasurement(ctx context.Context, in *Measurement, opts ...grpc.CallOption) (*Measurement, error) {
+	out := new(Measurement)
+	err := c.cc.Invoke(ctx, "/satellitecommunication.v1.SatelliteCommunication/AddMeasurement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *satelliteCommunicationClient) UpdateMeasurement(ctx context.Context, in *UpdateMeasurementRequest, opts ...grpc.CallOption) (*Measurement, error) {
+	out := new(Measurement)
+	err := c.cc.Invoke(ctx, "/satellitecommunication.v1.SatelliteCommunication/UpdateMeasurement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *satelliteCommunicationClient) DeleteMeasurement(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/satellitecommunication.v1.SatelliteCommunication/DeleteMeasurement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *satelliteCommunicationClient) UploadMeasurements(ctx context.Context, opts ...grpc.CallOption) (SatelliteCommunication_UploadMeasurementsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SatelliteCommunication_ServiceDesc.Streams[2], "/satellitecommunication.v1.SatelliteCommunication/UploadMeasurements", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &satelliteCommunicationUploadMeasurementsClient{stream}
+	return x, nil
+}
+
+type SatelliteCommunication_UploadMeasurementsClient interface {
+	Send(*UploadRequest) error
+	CloseAndRecv() (*UploadSummary, error)
+	grpc.ClientStream
+}
+
+type satelliteCommunicationUploadMeasurementsClient struct {
+	grpc.ClientStream
+}
+
+func (x *satelliteCommunicationUploadMeasurementsClient) Send(m *UploadRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *satelliteCommunicationUploadMeasurementsClient) CloseAndRecv() (*UploadSummary, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadSummary)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *satelliteCommunicationClient) IngestFile(ctx context.Context, opts ...grpc.CallOption) (SatelliteCommunication_IngestFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SatelliteCommunication_ServiceDesc.Streams[3], "/satellitecommunication.v1.SatelliteCommunication/IngestFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &satelliteCommunicationIngestFileClient{stream}
+	return x, nil
+}
+
+type SatelliteCommunication_IngestFileClient interface {
+	Send(*IngestChunk) error
+	CloseAndRecv() (*IngestReport, error)
+	grpc.ClientStream
+}
+
+type satelliteCommunicationIngestFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *satelliteCommunicationIngestFileClient) Send(m *IngestChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *satelliteCommunicationIngestFileClient) CloseAndRecv() (*IngestReport, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(IngestReport)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *satelliteCommunicationClient) AddComputation(ctx context.Context, in *Computation, opts ...grpc.CallOption) (*Computation, error) {
+	out := new(Computation)
+	err := c.cc.Invoke(ctx, "/satellitecommunication.v1.SatelliteCommunication/AddComputation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *satelliteCommunicationClient) ComputeStatistics(ctx context.Context, in *ComputeStatisticsRequest, opts ...grpc.CallOption) (*ComputeStatisticsResponse, error) {
+	out := new(ComputeStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/satellitecommunication.v1.SatelliteCommunication/ComputeStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *satelliteCommunicationClient) UpdateComputation(ctx context.Context, in *UpdateComputationRequest, opts ...grpc.CallOption) (*Computation, error) {
+	out := new(Computation)
+	err := c.cc.Invoke(ctx, "/satellitecommunication.v1.SatelliteCommunication/UpdateComputation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *satelliteCommunicationClient) DeleteComputation(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/satellitecommunication.v1.SatelliteCommunication/DeleteComputation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SatelliteCommunicationServer is the server API for SatelliteCommunication service.
+// All implementations must embed UnimplementedSatelliteCommunicationServer
+// for forward compatibility
+type SatelliteCommunicationServer interface {
+	GetMeasurements(context.Context, *SatelliteFilter) (*MeasurementResponse, error)
+	// GetMeasurementsBetween returns the measurements of a satellite taken in
+	// [startTime, endTime), ordered by timestamp. Unset times are unbounded.
+	GetMeasurementsBetween(context.Context, *TimestampFilter) (*MeasurementResponse, error)
+	// StreamMeasurements sends every measurement selected by the filter, one
+	// message at a time, ordered like GetMeasurementsBetween. Paging fields
+	// other than descending are ignored. Through the gateway the stream is
+	// returned as newline delimited JSON.
+	StreamMeasurements(*TimestampFilter, SatelliteCommunication_StreamMeasurementsServer) error
+	// WatchMeasurements sends the measurements selected by the filter as they
+	// are stored, until the client cancels. A client that reconnects sets
+	// afterId to the id of the last measurement it received to get the ones
+	// it missed first.
+	WatchMeasurements(*WatchFilter, SatelliteCommunication_WatchMeasurementsServer) error
+	GetComputations(context.Context, *SatelliteFilter) (*ComputationResponse, error)
+	AddSatellite(context.Context, *Satellite) (*Satellite, error)
+	// ListSatellites returns the satellites ordered by id. Soft deleted
+	// satellites are only listed if show_deleted is set. The other filter
+	// fields select the satellites with the given value when they are set.
+	ListSatellites(context.Context, *ListSatellitesRequest) (*SatelliteResponse, error)
+	// GetSatellite returns the satellite with the id, or the named one if id
+	// is 0. Soft deleted satellites are returned with their deleted time.
+	GetSatellite(context.Context, *GetSatelliteRequest) (*Satellite, error)
+	// UpdateSatellite writes the fields of satellite named by update_mask, or
+	// every field if it is empty, to the stored satellite with the same id.
+	UpdateSatellite(context.Context, *UpdateSatelliteRequest) (*Satellite, error)
+	// DeleteSatellite deletes the satellite with the id. A satellite with
+	// measurements or computations is only deleted together with them if
+	// cascade is set. With soft set, the satellite is only marked as deleted
+	// and keeps its data.
+	DeleteSatellite(context.Context, *DeleteSatelliteRequest) (*emptypb.Empty, error)
+	AddMeasurement(context.Context, *Measurement) (*Measurement, error)
+	// UpdateMeasurement writes the fields of measurement named by
+	// update_mask, or every field if it is empty, to the stored measurement
+	// with the same id. If measurement.version is set it must be the stored
+	// version. Each update increments the version.
+	UpdateMeasurement(context.Context, *UpdateMeasurementRequest) (*Measurement, error)
+	// DeleteMeasurement deletes the measurement with the id. If version is
+	// set it must be the stored version.
+	DeleteMeasurement(context.Context, *DeleteRequest) (*emptypb.Empty, error)
+	// UploadMeasurements stores a stream of measurements in a single
+	// transaction and records the upload as an ingest run. Measurements that
+	// fail validation are rejected and reported in the summary, the others
+	// are stored. Through the gateway the request body is newline delimited
+	// JSON.
+	UploadMeasurements(SatelliteCommunication_UploadMeasurementsServer) error
+	// IngestFile reads, computes and stores a csv file sent in chunks, the
+	// same way satelliteApp ingests a file, and returns the run report. The
+	// file is stored completely or not at all. Through the gateway the file
+	// is posted as the "file" part of a multipart form to /v1/ingest.
+	IngestFile(SatelliteCommunication_IngestFileServer) error
+	AddComputation(context.Context, *Computation) (*Computation, error)
+	// UpdateComputation works like UpdateMeasurement.
+	// ComputeStatistics computes the statistics of the measurements of a
+	// satellite taken in [startTime, endTime) the same way they are computed
+	// when a file is read. Only the requested statistics are set, all of them
+	// if none are requested. With persist set, the computation is also stored.
+	ComputeStatistics(context.Context, *ComputeStatisticsRequest) (*ComputeStatisticsResponse, error)
+	UpdateComputation(context.Context, *UpdateComputationRequest) (*Computation, error)
+	DeleteComputation(context.Context, *DeleteRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedSatelliteCommunicationServer()
+}
+
+// UnimplementedSatelliteCommunicationServer must be embedded to have forward compatible implementations.
+type UnimplementedSatelliteCommunicationServer struct {
+}
+
+func (UnimplementedSatelliteCommunicationServer) GetMeasurements(context.Context, *SatelliteFilter) (*MeasurementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMeasurements not implemented")
+}
+func (UnimplementedSatelliteCommunicationServer) GetMeasurementsBetween(context.Context, *TimestampFilter) (*MeasurementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMeasurementsBetween not implemented")
+}
+func (UnimplementedSatelliteCommunicationServer) StreamMeasurements(*TimestampFilter, SatelliteCommunication_StreamMeasurementsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamMeasurements not implemented")
+}
+func (UnimplementedSatelliteCommunicationServer) WatchMeasurements(*WatchFilter, SatelliteCommunication_WatchMeasurementsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchMeasurements not implemented")
+}
+func (UnimplementedSatelliteCommunicationServer) GetComputations(context.Context, *SatelliteFilter) (*ComputationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComputations not implemented")
+}
+func (UnimplementedSatelliteCommunicationServer) AddSatellite(context.Context, *Satellite) (*Satellite, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSatellite not implemented")
+}
+func (UnimplementedSatelliteCommunicationServer) ListSatellites(context.Context, *ListSatellitesRequest) (*SatelliteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSatellites not implemented")
+}
+func (UnimplementedSatelliteCommunicationServer) GetSatellite(context.Context, *GetSatelliteRequest) (*Satellite, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSatellite not implemented")
+}
+func (UnimplementedSatelliteCommunicationServer) UpdateSatellite(context.Context, *UpdateSatelliteRequest) (*Satellite, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSatellite not implemented")
+}
+func (UnimplementedSatelliteCommunicationServer) DeleteSatellite(context.Context, *DeleteSatelliteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSatellite not implemented")
+}
+func (UnimplementedSatelliteCommunicationServer) AddMeasurement(context.Context, *Measurement) (*Measurement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMeasurement not implemented")
+}
+func (UnimplementedSatelliteCommunicationServer) UpdateMeasurement(context.Context, *UpdateMeasurementRequest) (*Measurement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMeasurement not implemented")
+}
+func (UnimplementedSatelliteCommunicationServer) DeleteMeasurement(context.Context, *DeleteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMeasurement not implemented")
+}
+func (UnimplementedSatelliteCommunicationServer) UploadMeasurements(SatelliteCommunication_UploadMeasurementsServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadMeasurements not implemented")
+}
+func (UnimplementedSatelliteCommunicationServer) IngestFile(SatelliteCommunication_IngestFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method IngestFile not implemented")
+}
+func (UnimplementedSatelliteCommunicationServer) AddComputation(context.Context, *Computation) (*Computation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddComputation not implemented")
+}
+func (UnimplementedSatelliteCommunicationServer) ComputeStatistics(context.Context, *ComputeStatisticsRequest) (*ComputeStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComputeStatistics not implemented")
+}
+func (UnimplementedSatelliteCommunicationServer) UpdateComputation(context.Context, *UpdateComputationRequest) (*Computation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateComputation not implemented")
+}
+func (UnimplementedSatelliteCommunicationServer) DeleteComputation(context.Context, *DeleteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComputation not implemented")
+}
+func (UnimplementedSatelliteCommunicationServer) mustEmbedUnimplementedSatelliteCommunicationServer() {
+}
+
+// UnsafeSatelliteCommunicationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SatelliteCommunicationServer will
+// result in compilation errors.
+type UnsafeSatelliteCommunicationServer interface {
+	mustEmbedUnimplementedSatelliteCommunicationServer()
+}
+
+func RegisterSatelliteCommunicationServer(s grpc.ServiceRegistrar, srv SatelliteCommunicationServer) {
+	s.RegisterService(&SatelliteCommunication_ServiceDesc, srv)
+}
+
+func _SatelliteCommunication_GetMeasurements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SatelliteFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SatelliteCommunicationServer).GetMeasurements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/satellitecommunication.v1.SatelliteCommunication/GetMeasurements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SatelliteCommunicationServer).GetMeasurements(ctx, req.(*SatelliteFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SatelliteCommunication_GetMeasurementsBetween_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimestampFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SatelliteCommunicationServer).GetMeasurementsBetween(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/satellitecommunication.v1.SatelliteCommunication/GetMeasurementsBetween",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SatelliteCommunicationServer).GetMeasurementsBetween(ctx, req.(*TimestampFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SatelliteCommunication_StreamMeasurements_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TimestampFilter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SatelliteCommunicationServer).StreamMeasurements(m, &satelliteCommunicationStreamMeasurementsServer{stream})
+}
+
+type SatelliteCommunication_StreamMeasurementsServer interface {
+	Send(*Measurement) error
+	grpc.ServerStream
+}
+
+type satelliteCommunicationStreamMeasurementsServer struct {
+	grpc.ServerStream
+}
+
+func (x *satelliteCommunicationStreamMeasurementsServer) Send(m *Measurement) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SatelliteCommunication_WatchMeasurements_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchFilter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SatelliteCommunicationServer).WatchMeasurements(m, &satelliteCommunicationWatchMeasurementsServer{stream})
+}
+
+type SatelliteCommunication_WatchMeasurementsServer interface {
+	Send(*Measurement) error
+	grpc.ServerStream
+}
+
+type satelliteCommunicationWatchMeasurementsServer struct {
+	grpc.ServerStream
+}
+
+func (x *satelliteCommunicationWatchMeasurementsServer) Send(m *Measurement) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SatelliteCommunication_GetComputations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SatelliteFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SatelliteCommunicationServer).GetComputations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/satellitecommunication.v1.SatelliteCommunication/GetComputations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SatelliteCommunicationServer).GetComputations(ctx, req.(*SatelliteFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SatelliteCommunication_AddSatellite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Satellite)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SatelliteCommunicationServer).AddSatellite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/satellitecommunication.v1.SatelliteCommunication/AddSatellite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SatelliteCommunicationServer).AddSatellite(ctx, req.(*Satellite))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SatelliteCommunication_ListSatellites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSatellitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SatelliteCommunicationServer).ListSatellites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/satellitecommunication.v1.SatelliteCommunication/ListSatellites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SatelliteCommunicationServer).ListSatellites(ctx, req.(*ListSatellitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SatelliteCommunication_GetSatellite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSatelliteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SatelliteCommunicationServer).GetSatellite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/satellitecommunication.v1.SatelliteCommunication/GetSatellite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SatelliteCommunicationServer).GetSatellite(ctx, req.(*GetSatelliteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SatelliteCommunication_UpdateSatellite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSatelliteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SatelliteCommunicationServer).UpdateSatellite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/satellitecommunication.v1.SatelliteCommunication/UpdateSatellite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SatelliteCommunicationServer).UpdateSatellite(ctx, req.(*UpdateSatelliteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SatelliteCommunication_DeleteSatellite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSatelliteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SatelliteCommunicationServer).DeleteSatellite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/satellitecommunication.v1.SatelliteCommunication/DeleteSatellite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SatelliteCommunicationServer).DeleteSatellite(ctx, req.(*DeleteSatelliteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SatelliteCommunication_AddMeasurement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Measurement)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SatelliteCommunicationServer).AddMeasurement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/satellitecommunication.v1.SatelliteCommunication/AddMeasurement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SatelliteCommunicationServer).AddMeasurement(ctx, req.(*Measurement))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SatelliteCommunication_UpdateMeasurement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMeasurementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SatelliteCommunicationServer).UpdateMeasurement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/satellitecommunication.v1.SatelliteCommunication/UpdateMeasurement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SatelliteCommunicationServer).UpdateMeasurement(ctx, req.(*UpdateMeasurementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SatelliteCommunication_DeleteMeasurement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SatelliteCommunicationServer).DeleteMeasurement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/satellitecommunication.v1.SatelliteCommunication/DeleteMeasurement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SatelliteCommunicationServer).DeleteMeasurement(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SatelliteCommunication_UploadMeasurements_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SatelliteCommunicationServer).UploadMeasurements(&satelliteCommunicationUploadMeasurementsServer{stream})
+}
+
+type SatelliteCommunication_UploadMeasurementsServer interface {
+	SendAndClose(*UploadSummary) error
+	Recv() (*UploadRequest, error)
+	grpc.ServerStream
+}
+
+type satelliteCommunicationUploadMeasurementsServer struct {
+	grpc.ServerStream
+}
+
+func (x *satelliteCommunicationUploadMeasurementsServer) SendAndClose(m *UploadSummary) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *satelliteCommunicationUploadMeasurementsServer) Recv() (*UploadRequest, error) {
+	m := new(UploadRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _SatelliteCommunication_IngestFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SatelliteCommunicationServer).IngestFile(&satelliteCommunicationIngestFileServer{stream})
+}
+
+type SatelliteCommunication_IngestFileServer interface {
+	SendAndClose(*IngestReport) error
+	Recv() (*IngestChunk, error)
+	grpc.ServerStream
+}
+
+type satelliteCommunicationIngestFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *satelliteCommunicationIngestFileServer) SendAndClose(m *IngestReport) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *satelliteCommunicationIngestFileServer) Recv() (*IngestChunk, error) {
+	m := new(IngestChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _SatelliteCommunication_AddComputation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Computation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SatelliteCommunicationServer).AddComputation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/satellitecommunication.v1.SatelliteCommunication/AddComputation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SatelliteCommunicationServer).AddComputation(ctx, req.(*Computation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SatelliteCommunication_ComputeStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ComputeStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SatelliteCommunicationServer).ComputeStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/satellitecommunication.v1.SatelliteCommunication/ComputeStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SatelliteCommunicationServer).ComputeStatistics(ctx, req.(*ComputeStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SatelliteCommunication_UpdateComputation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateComputationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SatelliteCommunicationServer).UpdateComputation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/satellitecommunication.v1.SatelliteCommunication/UpdateComputation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SatelliteCommunicationServer).UpdateComputation(ctx, req.(*UpdateComputationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SatelliteCommunication_DeleteComputation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SatelliteCommunicationServer).DeleteComputation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/satellitecommunication.v1.SatelliteCommunication/DeleteComputation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SatelliteCommunicationServer).DeleteComputation(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SatelliteCommunication_ServiceDesc is the grpc.ServiceDesc for SatelliteCommunication service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SatelliteCommunication_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "satellitecommunication.v1.SatelliteCommunication",
+	HandlerType: (*SatelliteCommunicationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMeasurements",
+			Handler:    _SatelliteCommunication_GetMeasurements_Handler,
+		},
+		{
+			MethodName: "GetMeasurementsBetween",
+			Handler:    _SatelliteCommunication_GetMeasurementsBetween_Handler,
+		},
+		{
+			MethodName: "GetComputations",
+			Handler:    _SatelliteCommunication_GetComputations_Handler,
+		},
+		{
+			MethodName: "AddSatellite",
+			Handler:    _SatelliteCommunication_AddSatellite_Handler,
+		},
+		{
+			MethodName: "ListSatellites",
+			Handler:    _SatelliteCommunication_ListSatellites_Handler,
+		},
+		{
+			MethodName: "GetSatellite",
+			Handler:    _SatelliteCommunication_GetSatellite_Handler,
+		},
+		{
+			MethodName: "UpdateSatellite",
+			Handler:    _SatelliteCommunication_UpdateSatellite_Handler,
+		},
+		{
+			MethodName: "DeleteSatellite",
+			Handler:    _SatelliteCommunication_DeleteSatellite_Handler,
+		},
+		{
+			MethodName: "AddMeasurement",
+			Handler:    _SatelliteCommunication_AddMeasurement_Handler,
+		},
+		{
+			MethodName: "UpdateMeasurement",
+			Handler:    _SatelliteCommunication_UpdateMeasurement_Handler,
+		},
+		{
+			MethodName: "DeleteMeasurement",
+			Handler:    _SatelliteCommunication_DeleteMeasurement_Handler,
+		},
+		{
+			MethodName: "AddComputation",
+			Handler:    _SatelliteCommunication_AddComputation_Handler,
+		},
+		{
+			MethodName: "ComputeStatistics",
+			Handler:    _SatelliteCommunication_ComputeStatistics_Handler,
+		},
+		{
+			MethodName: "UpdateComputation",
+			Handler:    _SatelliteCommunication_UpdateComputation_Handler,
+		},
+		{
+			MethodName: "DeleteComputation",
+			Handler:    _SatelliteCommunication_DeleteComputation_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamMeasurements",
+			Handler:       _SatelliteCommunication_StreamMeasurements_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchMeasurements",
+			Handler:       _SatelliteCommunication_WatchMeasurements_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadMeasurements",
+			Handler:       _SatelliteCommunication_UploadMeasurements_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "IngestFile",
+			Handler:       _SatelliteCommunication_IngestFile_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "v1/satellite_communication.proto",
+}